@@ -0,0 +1,10 @@
+# Take the actions the schedule missed during an outage, e.g. with
+# terraform apply -invoke=action.temporal_schedule_backfill.outage
+action "temporal_schedule_backfill" "outage" {
+  config {
+    schedule_id    = temporal_schedule.example.schedule_id
+    start_time     = "2025-01-01T00:00:00Z"
+    end_time       = "2025-01-01T06:00:00Z"
+    overlap_policy = "BufferAll"
+  }
+}
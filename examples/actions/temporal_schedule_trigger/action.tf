@@ -0,0 +1,18 @@
+# Run the schedule once after its workflow is deployed
+action "temporal_schedule_trigger" "example" {
+  config {
+    schedule_id    = temporal_schedule.example.schedule_id
+    overlap_policy = "AllowAll"
+  }
+}
+
+resource "terraform_data" "workflow_version" {
+  input = var.workflow_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.temporal_schedule_trigger.example]
+    }
+  }
+}
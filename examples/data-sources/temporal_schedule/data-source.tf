@@ -0,0 +1,9 @@
+# Get a schedule, e.g. one managed in another configuration
+data "temporal_schedule" "example" {
+  schedule_id = "example-schedule"
+  namespace   = "default"
+}
+
+output "example_schedule_next_action_times" {
+  value = data.temporal_schedule.example.info.next_action_times
+}
@@ -0,0 +1,23 @@
+# Preview the next 5 action times of a schedule spec, e.g. to review a calendar change
+data "temporal_schedule_preview" "weekdays" {
+  limit = 5
+
+  spec = {
+    calendar_items = [{
+      hour        = "9"
+      minute      = "30"
+      day_of_week = "MON-FRI"
+    }]
+    time_zone = "Europe/Berlin"
+  }
+}
+
+output "weekdays_next_action_times" {
+  value = data.temporal_schedule_preview.weekdays.next_action_times
+}
+
+# Include the jitter the server applies to an existing schedule
+data "temporal_schedule_preview" "example" {
+  schedule_id = temporal_schedule.example.schedule_id
+  spec        = temporal_schedule.example.spec
+}
@@ -0,0 +1,12 @@
+# List all schedules in the provider namespace
+data "temporal_schedules" "all" {}
+
+# List paused schedules in the 'default' namespace using a visibility query
+data "temporal_schedules" "paused" {
+  namespace = "default"
+  query     = "TemporalSchedulePaused = true"
+}
+
+output "paused_schedule_ids" {
+  value = data.temporal_schedules.paused.schedules[*].schedule_id
+}
@@ -0,0 +1,6 @@
+# Import a schedule created outside Terraform, e.g. in the Temporal UI. With
+# terraform plan -generate-config-out=schedules.tf, Terraform writes its configuration.
+import {
+  to = temporal_schedule.example_schedule
+  id = "default:example"
+}
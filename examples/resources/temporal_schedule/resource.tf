@@ -1,6 +1,6 @@
 # Manage an example schedule.
 resource "temporal_schedule" "daily_cleanup" {
-  namespace   = default
+  namespace   = "default"
   schedule_id = "daily-cleanup-task"
 
   spec = {
@@ -16,6 +16,20 @@ resource "temporal_schedule" "daily_cleanup" {
       hour         = "11-14"
     }]
 
+    exclude_calendar_items = [{
+      month        = "1"
+      day_of_month = "1"
+      hour         = "*"
+      comment      = "New Year's Day"
+    }]
+
+    exclude_structured_calendar_items = [{
+      month        = [{ start = 12 }]
+      day_of_month = [{ start = 24, end = 26 }]
+      hour         = [{ start = 0, end = 23 }]
+      comment      = "Christmas"
+    }]
+
     time_zone = "UTC"
   }
 
@@ -45,7 +59,50 @@ resource "temporal_schedule" "daily_cleanup" {
         backoff_coefficient = 2.0
         maximum_interval    = "100s"
         maximum_attempts    = 5
+
+        non_retryable_error_types = ["InvalidArgumentError"]
+      }
+
+      memo = {
+        owner = "platform-team"
       }
+
+      priority = {
+        priority_key = 2
+      }
+    }
+  }
+}
+
+# Introduce a daily report mid-month and cover the earlier days of the month once, on creation.
+resource "temporal_schedule" "daily_report" {
+  schedule_id = "daily-report"
+
+  spec = {
+    calendar_items = [{
+      hour   = "6"
+      minute = "0"
+    }]
+  }
+
+  state = {}
+
+  policy_config = {
+    overlap_policy = "BufferAll"
+  }
+
+  initial_patch = {
+    backfill = [{
+      start_time = "2025-06-01T00:00:00Z"
+      end_time   = "2025-06-16T00:00:00Z"
+    }]
+  }
+
+  action = {
+    workflow = {
+      workflow_type = "DailyReportWorkflow"
+      task_queue    = "reports"
+      workflow_id   = "daily-report"
     }
   }
 }
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	schedulev1 "go.temporal.io/api/schedule/v1"
+)
+
+// calendarField describes the values accepted by one field of a calendar expression,
+// following the rules the Temporal server uses to parse CalendarSpec strings.
+type calendarField struct {
+	name string
+	min  int
+	max  int
+	// def is used when the field is empty.
+	def string
+	// names are the full value names; any prefix of at least three letters is accepted.
+	names []string
+	// sundaySeven accepts 7 as an alias of 0 (Sunday).
+	sundaySeven bool
+}
+
+var (
+	calendarSecond     = calendarField{name: "second", min: 0, max: 59, def: "0"}
+	calendarMinute     = calendarField{name: "minute", min: 0, max: 59, def: "0"}
+	calendarHour       = calendarField{name: "hour", min: 0, max: 23, def: "0"}
+	calendarDayOfMonth = calendarField{name: "day_of_month", min: 1, max: 31, def: "*"}
+	calendarMonth      = calendarField{name: "month", min: 1, max: 12, def: "*", names: []string{
+		"january", "february", "march", "april", "may", "june",
+		"july", "august", "september", "october", "november", "december",
+	}}
+	calendarYear      = calendarField{name: "year", min: 2000, max: 2100, def: "*"}
+	calendarDayOfWeek = calendarField{name: "day_of_week", min: 0, max: 6, def: "*", sundaySeven: true, names: []string{
+		"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday",
+	}}
+)
+
+// parse converts a calendar field such as "MON-FRI", "*/15" or "1,15" into ranges.
+// An empty string parses as the field default. "*" for year parses as no ranges,
+// which Temporal treats as every year.
+func (f calendarField) parse(value string) ([]*schedulev1.Range, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		value = f.def
+	}
+	if value == "*" && f.name == calendarYear.name {
+		return nil, nil
+	}
+
+	var ranges []*schedulev1.Range
+	for _, part := range strings.Split(value, ",") {
+		r, err := f.parseRange(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", f.name, value, err)
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+func (f calendarField) parseRange(s string) (*schedulev1.Range, error) {
+	step := 1
+	if before, after, ok := strings.Cut(s, "/"); ok {
+		var err error
+		step, err = strconv.Atoi(after)
+		if err != nil || step < 1 {
+			return nil, fmt.Errorf("step %q must be a positive integer", after)
+		}
+		s = before
+	}
+
+	if s == "*" {
+		return &schedulev1.Range{Start: int32(f.min), End: int32(f.max), Step: int32(step)}, nil
+	}
+
+	startValue, endValue, isRange := strings.Cut(s, "-")
+	start, err := f.parseValue(startValue)
+	if err != nil {
+		return nil, err
+	}
+	end := start
+	switch {
+	case isRange:
+		if end, err = f.parseValue(endValue); err != nil {
+			return nil, err
+		}
+		if end < start {
+			return nil, fmt.Errorf("range %q ends before it starts", s)
+		}
+	case step > 1:
+		// "5/15" steps from 5 to the maximum value, as in cron.
+		end = f.max
+	}
+
+	return &schedulev1.Range{Start: int32(start), End: int32(end), Step: int32(step)}, nil
+}
+
+func (f calendarField) parseValue(s string) (int, error) {
+	if len(s) >= 3 {
+		lower := strings.ToLower(s)
+		for i, name := range f.names {
+			if strings.HasPrefix(name, lower) {
+				return f.min + i, nil
+			}
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	upper := f.max
+	if f.sundaySeven {
+		upper = 7
+	}
+	if v < f.min || v > upper {
+		return 0, fmt.Errorf("%d is out of range %d-%d", v, f.min, upper)
+	}
+
+	return v, nil
+}
+
+// matches returns the field values matched by ranges, or nil when every value matches,
+// which only happens for a year without ranges.
+func (f calendarField) matches(ranges []*schedulev1.Range) []bool {
+	if len(ranges) == 0 && f.name == calendarYear.name {
+		return nil
+	}
+
+	matched := make([]bool, f.max+1)
+	for _, r := range ranges {
+		end, step := int(r.GetEnd()), int(r.GetStep())
+		if end < int(r.GetStart()) {
+			end = int(r.GetStart())
+		}
+		if step < 1 {
+			step = 1
+		}
+		for v := int(r.GetStart()); v <= end; v += step {
+			value := v
+			if f.sundaySeven && value == 7 {
+				value = 0
+			}
+			if value >= 0 && value <= f.max {
+				matched[value] = true
+			}
+		}
+	}
+
+	return matched
+}
+
+// equal reports whether both range lists match the same values.
+func (f calendarField) equal(a, b []*schedulev1.Range) bool {
+	matchedA, matchedB := f.matches(a), f.matches(b)
+	if (matchedA == nil) != (matchedB == nil) {
+		return false
+	}
+	for i := range matchedA {
+		if matchedA[i] != matchedB[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// format renders ranges in their canonical string form: "*" when every value matches,
+// otherwise the comma-separated ranges.
+func (f calendarField) format(ranges []*schedulev1.Range) string {
+	matched := f.matches(ranges)
+	if matched == nil {
+		return "*"
+	}
+	all := true
+	for v := f.min; v <= f.max; v++ {
+		all = all && matched[v]
+	}
+	if all && f.name != calendarYear.name {
+		return "*"
+	}
+
+	return formatRanges(ranges)
+}
+
+// keepCalendarString returns the prior string when it matches the same values as ranges,
+// so equivalent expressions such as "MON-FRI" and "1-5" do not show up as drift. Without
+// a prior string, ranges are formatted canonically.
+func (f calendarField) keepCalendarString(prior string, ranges []*schedulev1.Range) string {
+	if prior == "" {
+		return f.format(ranges)
+	}
+	if priorRanges, err := f.parse(prior); err == nil && f.equal(priorRanges, ranges) {
+		return prior
+	}
+
+	return f.format(ranges)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	schedulev1 "go.temporal.io/api/schedule/v1"
+)
+
+// TestCalendarField_Parse verifies that calendar strings parse into the ranges Temporal uses.
+func TestCalendarField_Parse(t *testing.T) {
+	tests := []struct {
+		field calendarField
+		value string
+		want  string
+	}{
+		{calendarDayOfWeek, "MON-FRI", "1-5"},
+		{calendarDayOfWeek, "sunday,Sat", "0,6"},
+		{calendarMonth, "jan,March-may", "1,3-5"},
+		{calendarMinute, "*/15", "0-59/15"},
+		{calendarMinute, "5/15", "5-59/15"},
+		{calendarMinute, "", "0"},
+		{calendarDayOfMonth, "", "1-31"},
+		{calendarYear, "*", ""},
+		{calendarYear, "2025-2026", "2025-2026"},
+	}
+	for _, tt := range tests {
+		ranges, err := tt.field.parse(tt.value)
+		if err != nil {
+			t.Errorf("%s %q: unexpected error: %v", tt.field.name, tt.value, err)
+			continue
+		}
+		if got := formatRanges(ranges); got != tt.want {
+			t.Errorf("%s %q: got %q, want %q", tt.field.name, tt.value, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		field calendarField
+		value string
+	}{
+		{calendarHour, "24"},
+		{calendarMonth, "ja"},
+		{calendarDayOfMonth, "10-5"},
+		{calendarMinute, "*/0"},
+		{calendarYear, "1999"},
+	} {
+		if _, err := tt.field.parse(tt.value); err == nil {
+			t.Errorf("%s %q: expected an error", tt.field.name, tt.value)
+		}
+	}
+}
+
+// TestCalendarField_KeepCalendarString verifies that equivalent expressions keep the
+// configured form and that changed values are read back in canonical form.
+func TestCalendarField_KeepCalendarString(t *testing.T) {
+	tests := []struct {
+		field  calendarField
+		prior  string
+		ranges []*schedulev1.Range
+		want   string
+	}{
+		{calendarDayOfWeek, "MON-FRI", []*schedulev1.Range{{Start: 1, End: 5, Step: 1}}, "MON-FRI"},
+		{calendarDayOfWeek, "0-6", []*schedulev1.Range{{Start: 0, End: 6, Step: 1}}, "0-6"},
+		{calendarDayOfWeek, "7", []*schedulev1.Range{{Start: 0}}, "7"},
+		{calendarMinute, "*", []*schedulev1.Range{{Start: 0, End: 59, Step: 1}}, "*"},
+		{calendarMinute, "0-59", []*schedulev1.Range{{Start: 0, End: 59, Step: 1}}, "0-59"},
+		{calendarYear, "*", nil, "*"},
+		{calendarHour, "9", []*schedulev1.Range{{Start: 10, End: 10, Step: 1}}, "10"},
+		{calendarMonth, "", []*schedulev1.Range{{Start: 1, End: 12, Step: 1}}, "*"},
+		{calendarMonth, "1", []*schedulev1.Range{{Start: 1, End: 12, Step: 1}}, "*"},
+	}
+	for _, tt := range tests {
+		if got := tt.field.keepCalendarString(tt.prior, tt.ranges); got != tt.want {
+			t.Errorf("%s %q: got %q, want %q", tt.field.name, tt.prior, got, tt.want)
+		}
+	}
+}
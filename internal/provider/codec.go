@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	commonv1 "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// codecTimeout bounds a single request to the remote codec server.
+	codecTimeout = 30 * time.Second
+
+	// maxCodecResponseSize bounds the response body read from the remote codec server.
+	maxCodecResponseSize = 64 * 1024 * 1024
+)
+
+// payloadCodec runs payloads through a Temporal remote codec server using the standard
+// POST /encode and /decode HTTP API, as the Temporal CLI and Web UI do. A nil codec
+// leaves payloads unchanged.
+type payloadCodec struct {
+	endpoint  string
+	auth      string
+	namespace string
+	client    *http.Client
+}
+
+// newPayloadCodec returns a codec for the server at endpoint. The auth value, if set,
+// is sent as the Authorization header.
+func newPayloadCodec(endpoint, auth string) *payloadCodec {
+	return &payloadCodec{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		auth:     auth,
+		client:   &http.Client{Timeout: codecTimeout},
+	}
+}
+
+// withNamespace returns a copy of the codec that sends namespace in the X-Namespace header.
+func (c *payloadCodec) withNamespace(namespace string) *payloadCodec {
+	if c == nil {
+		return nil
+	}
+
+	codec := *c
+	codec.namespace = namespace
+	return &codec
+}
+
+// encode transforms payloads with the codec server's /encode endpoint.
+func (c *payloadCodec) encode(ctx context.Context, payloads []*commonv1.Payload) ([]*commonv1.Payload, error) {
+	return c.transform(ctx, "/encode", payloads)
+}
+
+// decode transforms payloads with the codec server's /decode endpoint.
+func (c *payloadCodec) decode(ctx context.Context, payloads []*commonv1.Payload) ([]*commonv1.Payload, error) {
+	return c.transform(ctx, "/decode", payloads)
+}
+
+func (c *payloadCodec) transform(ctx context.Context, endpointPath string, payloads []*commonv1.Payload) ([]*commonv1.Payload, error) {
+	if c == nil || len(payloads) == 0 {
+		return payloads, nil
+	}
+
+	body, err := protojson.Marshal(&commonv1.Payloads{Payloads: payloads})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payloads: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+endpointPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create codec request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.namespace != "" {
+		req.Header.Set("X-Namespace", c.namespace)
+	}
+	if c.auth != "" {
+		req.Header.Set("Authorization", c.auth)
+	}
+
+	tflog.Debug(ctx, "Calling payload codec", map[string]interface{}{
+		"codec_url": c.endpoint + endpointPath,
+		"payloads":  len(payloads),
+	})
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("codec request to %s failed: %w", c.endpoint+endpointPath, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxCodecResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read codec response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("codec server %s returned %s: %s", c.endpoint+endpointPath, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var result commonv1.Payloads
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal codec response: %w", err)
+	}
+	if len(result.GetPayloads()) != len(payloads) {
+		return nil, fmt.Errorf("codec server %s returned %d payloads, expected %d", c.endpoint+endpointPath, len(result.GetPayloads()), len(payloads))
+	}
+
+	return result.GetPayloads(), nil
+}
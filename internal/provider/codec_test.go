@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeCodecServer implements the remote codec server API. Encoding reverses the payload
+// data and marks it with the "binary/fake" encoding; decoding restores the original.
+type fakeCodecServer struct {
+	namespaces []string
+	auth       []string
+}
+
+func (f *fakeCodecServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.namespaces = append(f.namespaces, r.Header.Get("X-Namespace"))
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var payloads commonv1.Payloads
+	if err := protojson.Unmarshal(body, &payloads); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, p := range payloads.Payloads {
+		switch r.URL.Path {
+		case "/encode":
+			p.Metadata = map[string][]byte{
+				"encoding":          []byte("binary/fake"),
+				"original-encoding": p.Metadata["encoding"],
+			}
+		case "/decode":
+			if string(p.Metadata["encoding"]) != "binary/fake" {
+				continue
+			}
+			p.Metadata = map[string][]byte{"encoding": p.Metadata["original-encoding"]}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		p.Data = reversed(p.Data)
+	}
+
+	out, err := protojson.Marshal(&payloads)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
+
+func reversed(data []byte) []byte {
+	out := bytes.Clone(data)
+	slices.Reverse(out)
+	return out
+}
+
+// TestPayloadCodec_ScheduleActionRoundtrip verifies that workflow inputs, memo and
+// header are encoded with the codec, search attributes are not, and everything reads back.
+func TestPayloadCodec_ScheduleActionRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	server := &fakeCodecServer{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	codec := newPayloadCodec(httpServer.URL+"/", "Bearer codec-token").withNamespace("payments")
+
+	model := &ScheduleActionModel{
+		Workflow: &WorkflowActionModel{
+			WorkflowID:   types.StringValue("cleanup"),
+			WorkflowType: types.StringValue("CleanupWorkflow"),
+			TaskQueue:    types.StringValue("cleanup-queue"),
+			Input:        jsonNull(),
+			Inputs:       []jsonValue{jsonStringValue(`{"card":"4111"}`), jsonStringValue(`2`)},
+			Memo: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner": types.StringValue("team-a"),
+				"cost":  types.StringValue("low"),
+			}),
+			SearchAttributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"CustomerId": types.StringValue("c-42"),
+			}),
+			Header: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"tenant": types.StringValue("acme"),
+			}),
+		},
+	}
+
+	saTypes := map[string]enums.IndexedValueType{"CustomerId": enums.INDEXED_VALUE_TYPE_KEYWORD}
+	action, diags := convertToScheduleAction(ctx, codec, model, saTypes)
+	if diags.HasError() {
+		t.Fatalf("convertToScheduleAction: %v", diags)
+	}
+
+	workflow := action.GetStartWorkflow()
+	for _, p := range append(workflow.GetInput().GetPayloads(),
+		workflow.GetMemo().GetFields()["owner"], workflow.GetHeader().GetFields()["tenant"]) {
+		if string(p.GetMetadata()["encoding"]) != "binary/fake" {
+			t.Errorf("expected an encoded payload, got %v", p)
+		}
+	}
+	if sa := workflow.GetSearchAttributes().GetIndexedFields()["CustomerId"]; string(sa.GetData()) != `"c-42"` {
+		t.Errorf("search attributes must not be encoded, got %v", sa)
+	}
+
+	got, diags := convertScheduleAction(ctx, codec, action, nil)
+	if diags.HasError() {
+		t.Fatalf("convertScheduleAction: %v", diags)
+	}
+	if !reflect.DeepEqual(got, model) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got.Workflow, model.Workflow)
+	}
+
+	for i := range server.namespaces {
+		if server.namespaces[i] != "payments" || server.auth[i] != "Bearer codec-token" {
+			t.Errorf("request %d: got namespace %q and auth %q", i, server.namespaces[i], server.auth[i])
+		}
+	}
+}
+
+// TestPayloadCodec_Memo verifies that memos are encoded and decoded in a single request each.
+func TestPayloadCodec_Memo(t *testing.T) {
+	ctx := context.Background()
+	server := &fakeCodecServer{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	codec := newPayloadCodec(httpServer.URL, "")
+	memo := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"a": types.StringValue("1"),
+		"b": types.StringValue("2"),
+	})
+
+	encoded, diags := convertToMemo(ctx, codec, memo)
+	if diags.HasError() {
+		t.Fatalf("convertToMemo: %v", diags)
+	}
+	decoded, diags := convertMemo(ctx, codec, encoded)
+	if diags.HasError() {
+		t.Fatalf("convertMemo: %v", diags)
+	}
+	if !decoded.Equal(memo) {
+		t.Errorf("got %v, want %v", decoded, memo)
+	}
+	if len(server.namespaces) != 2 {
+		t.Errorf("expected one encode and one decode request, got %d", len(server.namespaces))
+	}
+}
+
+// TestPayloadCodec_ServerError verifies that codec failures surface as errors.
+func TestPayloadCodec_ServerError(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "key not found", http.StatusInternalServerError)
+	}))
+	defer httpServer.Close()
+
+	_, err := newPayloadCodec(httpServer.URL, "").encode(context.Background(), []*commonv1.Payload{{Data: []byte(`"x"`)}})
+	if err == nil || !strings.Contains(err.Error(), "key not found") {
+		t.Errorf("expected the codec server error, got %v", err)
+	}
+}
+
+// TestPayloadCodec_Nil verifies that a nil codec leaves payloads unchanged.
+func TestPayloadCodec_Nil(t *testing.T) {
+	var codec *payloadCodec
+	payloads := []*commonv1.Payload{{Data: []byte(`"x"`)}}
+
+	got, err := codec.withNamespace("default").encode(context.Background(), payloads)
+	if err != nil || !reflect.DeepEqual(got, payloads) {
+		t.Errorf("got %v, %v", got, err)
+	}
+}
@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"go.temporal.io/api/enums/v1"
 )
 
@@ -11,3 +16,29 @@ var (
 		"Enabled":     enums.ARCHIVAL_STATE_ENABLED,
 	}
 )
+
+// planProviderNamespace sets the planned "namespace" attribute to the provider namespace
+// when the configuration omits it. Changing the provider namespace afterwards replaces the resource.
+func planProviderNamespace(ctx context.Context, providerNamespace string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or before the provider has been configured.
+	if req.Plan.Raw.IsNull() || providerNamespace == "" {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("namespace"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("namespace"), providerNamespace)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var prior types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("namespace"), &prior)...)
+	if !prior.IsNull() && prior.ValueString() != providerNamespace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("namespace"))
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// cronShorthands are the predefined schedules accepted instead of the time fields.
+var cronShorthands = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSpec is a cron string parsed the way the Temporal server parses ScheduleSpec.cron_string:
+// either a structured calendar or, for "@every", an interval.
+type cronSpec struct {
+	calendar *schedulev1.StructuredCalendarSpec
+	interval *schedulev1.IntervalSpec
+	// timeZone is set by a CRON_TZ= or TZ= prefix.
+	timeZone string
+}
+
+// parseCronString parses a cron string with 5 (minute to day of week), 6 (plus year) or
+// 7 (second to year) fields, a shorthand such as "@daily" or "@every 1h[/5m]", an optional
+// CRON_TZ= prefix and an optional "#" comment.
+func parseCronString(s string) (*cronSpec, error) {
+	result := &cronSpec{}
+	comment := ""
+	if before, after, ok := strings.Cut(s, "#"); ok {
+		s, comment = before, strings.TrimSpace(after)
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) > 0 {
+		for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+			if tz, ok := strings.CutPrefix(fields[0], prefix); ok {
+				result.timeZone = tz
+				fields = fields[1:]
+				break
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("cron string %q has no fields", s)
+	}
+
+	if fields[0] == "@every" {
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("@every expects a single interval, got %q", s)
+		}
+		interval, err := parseCronInterval(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		result.interval = interval
+		return result, nil
+	}
+	if expanded, ok := cronShorthands[fields[0]]; ok && len(fields) == 1 {
+		fields = strings.Fields(expanded)
+	}
+
+	var second, minute, hour, dayOfMonth, month, dayOfWeek, year string
+	switch len(fields) {
+	case 5:
+		minute, hour, dayOfMonth, month, dayOfWeek = fields[0], fields[1], fields[2], fields[3], fields[4]
+	case 6:
+		minute, hour, dayOfMonth, month, dayOfWeek, year = fields[0], fields[1], fields[2], fields[3], fields[4], fields[5]
+	case 7:
+		second, minute, hour, dayOfMonth, month, dayOfWeek, year = fields[0], fields[1], fields[2], fields[3], fields[4], fields[5], fields[6]
+	default:
+		return nil, fmt.Errorf("cron string %q must have 5, 6 or 7 fields, got %d", s, len(fields))
+	}
+
+	calendar := &schedulev1.StructuredCalendarSpec{Comment: comment}
+	for _, f := range []struct {
+		field calendarField
+		value string
+		out   *[]*schedulev1.Range
+	}{
+		{calendarSecond, second, &calendar.Second},
+		{calendarMinute, minute, &calendar.Minute},
+		{calendarHour, hour, &calendar.Hour},
+		{calendarDayOfMonth, dayOfMonth, &calendar.DayOfMonth},
+		{calendarMonth, month, &calendar.Month},
+		{calendarYear, year, &calendar.Year},
+		{calendarDayOfWeek, dayOfWeek, &calendar.DayOfWeek},
+	} {
+		ranges, err := f.field.parse(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("cron string %q: %w", s, err)
+		}
+		*f.out = ranges
+	}
+	result.calendar = calendar
+
+	return result, nil
+}
+
+// parseCronInterval parses the "<interval>[/<phase>]" argument of "@every".
+func parseCronInterval(s string) (*schedulev1.IntervalSpec, error) {
+	every, phase, hasPhase := strings.Cut(s, "/")
+	interval, err := parseCronDuration(every)
+	if err != nil {
+		return nil, err
+	}
+	spec := &schedulev1.IntervalSpec{Interval: durationpb.New(interval)}
+	if hasPhase {
+		offset, err := parseCronDuration(phase)
+		if err != nil {
+			return nil, err
+		}
+		spec.Phase = durationpb.New(offset)
+	}
+
+	return spec, nil
+}
+
+// parseCronDuration parses a Go duration, also accepting a whole number of days such as "7d".
+func parseCronDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+
+	return d, nil
+}
+
+// matches reports whether the cron string parses into the given calendar or interval.
+func (c *cronSpec) matches(calendar *schedulev1.StructuredCalendarSpec, interval *schedulev1.IntervalSpec) bool {
+	if c.interval != nil {
+		return interval != nil && c.interval.GetInterval().AsDuration() == interval.GetInterval().AsDuration() &&
+			c.interval.GetPhase().AsDuration() == interval.GetPhase().AsDuration()
+	}
+
+	return calendar != nil && c.calendar.GetComment() == calendar.GetComment() &&
+		calendarSecond.equal(c.calendar.GetSecond(), calendar.GetSecond()) &&
+		calendarMinute.equal(c.calendar.GetMinute(), calendar.GetMinute()) &&
+		calendarHour.equal(c.calendar.GetHour(), calendar.GetHour()) &&
+		calendarDayOfMonth.equal(c.calendar.GetDayOfMonth(), calendar.GetDayOfMonth()) &&
+		calendarMonth.equal(c.calendar.GetMonth(), calendar.GetMonth()) &&
+		calendarYear.equal(c.calendar.GetYear(), calendar.GetYear()) &&
+		calendarDayOfWeek.equal(c.calendar.GetDayOfWeek(), calendar.GetDayOfWeek())
+}
+
+// formatCronCalendar renders a structured calendar as a 7-field cron string.
+func formatCronCalendar(calendar *schedulev1.StructuredCalendarSpec) string {
+	s := strings.Join([]string{
+		calendarSecond.format(calendar.GetSecond()),
+		calendarMinute.format(calendar.GetMinute()),
+		calendarHour.format(calendar.GetHour()),
+		calendarDayOfMonth.format(calendar.GetDayOfMonth()),
+		calendarMonth.format(calendar.GetMonth()),
+		calendarDayOfWeek.format(calendar.GetDayOfWeek()),
+		calendarYear.format(calendar.GetYear()),
+	}, " ")
+	if calendar.GetComment() != "" {
+		s += " #" + calendar.GetComment()
+	}
+
+	return s
+}
+
+// formatCronInterval renders an interval as an "@every" cron string.
+func formatCronInterval(interval *schedulev1.IntervalSpec) string {
+	s := "@every " + formatDurationCanonical(interval.GetInterval())
+	if interval.GetPhase().AsDuration() > 0 {
+		s += "/" + formatDurationCanonical(interval.GetPhase())
+	}
+
+	return s
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseCronString verifies that cron strings parse into the structured calendars and
+// intervals the Temporal server converts them to.
+func TestParseCronString(t *testing.T) {
+	tests := []struct {
+		cron     string
+		want     string
+		timeZone string
+	}{
+		{"15 8 * * *", "0 15 8 * * * *", ""},
+		{"0 9 * * MON-FRI", "0 0 9 * * 1-5 *", ""},
+		{"30 0 9 1 JAN * 2030", "30 0 9 1 1 * 2030", ""},
+		{"@daily", "0 0 0 * * * *", ""},
+		{"@weekly", "0 0 0 * * 0 *", ""},
+		{"CRON_TZ=Europe/Berlin 0 9 * * * # morning run", "0 0 9 * * * * #morning run", "Europe/Berlin"},
+	}
+	for _, tt := range tests {
+		cron, err := parseCronString(tt.cron)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.cron, err)
+			continue
+		}
+		if got := formatCronCalendar(cron.calendar); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.cron, got, tt.want)
+		}
+		if cron.timeZone != tt.timeZone {
+			t.Errorf("%q: got time zone %q, want %q", tt.cron, cron.timeZone, tt.timeZone)
+		}
+	}
+
+	cron, err := parseCronString("@every 7d/1h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cron.calendar != nil || cron.interval.GetInterval().AsDuration() != 7*24*time.Hour ||
+		cron.interval.GetPhase().AsDuration() != time.Hour {
+		t.Errorf("unexpected @every spec: %+v", cron)
+	}
+
+	for _, invalid := range []string{"", "* * *", "61 * * * *", "@every", "@every 0s", "@sometimes"} {
+		if _, err := parseCronString(invalid); err == nil {
+			t.Errorf("%q: expected an error", invalid)
+		}
+	}
+}
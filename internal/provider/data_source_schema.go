@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// dataSourceAttributeMode selects how a resource attribute is exposed by a data source.
+type dataSourceAttributeMode int
+
+const (
+	// dataSourceComputed exposes the attribute as read-only data, e.g. for a data source
+	// reading an existing object.
+	dataSourceComputed dataSourceAttributeMode = iota
+	// dataSourceConfig accepts the same configuration as the resource, e.g. for a data
+	// source computing something from it. Resource defaults are applied when the
+	// configuration is converted, so attributes that are not required become optional.
+	dataSourceConfig
+)
+
+// flags returns the required, optional and computed flags of a data source attribute
+// converted from a resource attribute.
+func (m dataSourceAttributeMode) flags(attribute rschema.Attribute) (required, optional, computed bool) {
+	if m == dataSourceComputed {
+		return false, false, true
+	}
+
+	return attribute.IsRequired(), !attribute.IsRequired(), false
+}
+
+// modeValidators returns the resource validators when the attribute accepts configuration.
+func modeValidators[T any](mode dataSourceAttributeMode, validators []T) []T {
+	if mode == dataSourceComputed {
+		return nil
+	}
+
+	return validators
+}
+
+// dataSourceAttribute converts a resource schema attribute into a data source attribute
+// with the same type and description. An error is returned for attribute kinds the data
+// source schema has no equivalent for.
+func dataSourceAttribute(attribute rschema.Attribute, mode dataSourceAttributeMode) (dschema.Attribute, error) {
+	required, optional, computed := mode.flags(attribute)
+
+	switch a := attribute.(type) {
+	case rschema.StringAttribute:
+		return dschema.StringAttribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.BoolAttribute:
+		return dschema.BoolAttribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.Int32Attribute:
+		return dschema.Int32Attribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.Int64Attribute:
+		return dschema.Int64Attribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.Float32Attribute:
+		return dschema.Float32Attribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.Float64Attribute:
+		return dschema.Float64Attribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.NumberAttribute:
+		return dschema.NumberAttribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.DynamicAttribute:
+		return dschema.DynamicAttribute{
+			MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.MapAttribute:
+		return dschema.MapAttribute{
+			MarkdownDescription: a.MarkdownDescription, ElementType: a.ElementType, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.ListAttribute:
+		return dschema.ListAttribute{
+			MarkdownDescription: a.MarkdownDescription, ElementType: a.ElementType, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.SetAttribute:
+		return dschema.SetAttribute{
+			MarkdownDescription: a.MarkdownDescription, ElementType: a.ElementType, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.ObjectAttribute:
+		return dschema.ObjectAttribute{
+			MarkdownDescription: a.MarkdownDescription, AttributeTypes: a.AttributeTypes, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.ListNestedAttribute:
+		nested, err := dataSourceNestedObject(a.NestedObject, mode)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription, NestedObject: nested, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.SetNestedAttribute:
+		nested, err := dataSourceNestedObject(a.NestedObject, mode)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.SetNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription, NestedObject: nested, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.MapNestedAttribute:
+		nested, err := dataSourceNestedObject(a.NestedObject, mode)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.MapNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription, NestedObject: nested, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	case rschema.SingleNestedAttribute:
+		attributes, err := dataSourceAttributes(a.Attributes, mode)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription, Attributes: attributes, CustomType: a.CustomType, Sensitive: a.Sensitive,
+			Required: required, Optional: optional, Computed: computed, Validators: modeValidators(mode, a.Validators),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute type %T", attribute)
+	}
+}
+
+func dataSourceNestedObject(object rschema.NestedAttributeObject, mode dataSourceAttributeMode) (dschema.NestedAttributeObject, error) {
+	attributes, err := dataSourceAttributes(object.Attributes, mode)
+	if err != nil {
+		return dschema.NestedAttributeObject{}, err
+	}
+
+	return dschema.NestedAttributeObject{
+		Attributes: attributes,
+		CustomType: object.CustomType,
+		Validators: modeValidators(mode, object.Validators),
+	}, nil
+}
+
+func dataSourceAttributes(attributes map[string]rschema.Attribute, mode dataSourceAttributeMode) (map[string]dschema.Attribute, error) {
+	result := make(map[string]dschema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		converted, err := dataSourceAttribute(attribute, mode)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = converted
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// TestDataSourceAttribute_ResourceSchema verifies that every attribute of the
+// temporal_schedule resource converts in both modes, not only the ones the data sources
+// expose today, and that the results form a valid schema.
+func TestDataSourceAttribute_ResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	var resourceSchema resource.SchemaResponse
+	NewScheduleResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	tests := []struct {
+		name  string
+		mode  dataSourceAttributeMode
+		check func(t *testing.T, name string, resourceAttribute rschema.Attribute, attribute dschema.Attribute)
+	}{
+		{
+			name: "computed",
+			mode: dataSourceComputed,
+			check: func(t *testing.T, name string, _ rschema.Attribute, attribute dschema.Attribute) {
+				if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+					t.Errorf("attribute %q must only be computed", name)
+				}
+			},
+		},
+		{
+			name: "config",
+			mode: dataSourceConfig,
+			check: func(t *testing.T, name string, resourceAttribute rschema.Attribute, attribute dschema.Attribute) {
+				if attribute.IsComputed() {
+					t.Errorf("attribute %q must not be computed", name)
+				}
+				if attribute.IsRequired() != resourceAttribute.IsRequired() || attribute.IsOptional() == resourceAttribute.IsRequired() {
+					t.Errorf("attribute %q must be required only when the resource requires it", name)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes, err := dataSourceAttributes(resourceSchema.Schema.Attributes, tt.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(attributes) != len(resourceSchema.Schema.Attributes) {
+				t.Fatalf("expected %d attributes, got %d", len(resourceSchema.Schema.Attributes), len(attributes))
+			}
+			for name, attribute := range attributes {
+				tt.check(t, name, resourceSchema.Schema.Attributes[name], attribute)
+			}
+			if diags := (dschema.Schema{Attributes: attributes}).ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid schema: %v", diags)
+			}
+		})
+	}
+}
+
+// unsupportedAttribute is a resource attribute kind the converter does not know about.
+type unsupportedAttribute struct {
+	rschema.StringAttribute
+}
+
+// TestDataSourceAttribute_Unsupported verifies that unknown attribute kinds are reported
+// as an error naming the attribute instead of panicking.
+func TestDataSourceAttribute_Unsupported(t *testing.T) {
+	_, err := dataSourceAttributes(map[string]rschema.Attribute{
+		"spec": rschema.SingleNestedAttribute{
+			Attributes: map[string]rschema.Attribute{"custom": unsupportedAttribute{}},
+		},
+	}, dataSourceConfig)
+	if err == nil || !strings.Contains(err.Error(), "spec: custom: unsupported attribute type") {
+		t.Fatalf("expected unsupported attribute error, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeWorkflowService answers schedule calls with fixed errors and a fixed memo. Other calls are unimplemented.
+type fakeWorkflowService struct {
+	workflowservice.UnimplementedWorkflowServiceServer
+	describeErr error
+	updateErr   error
+	deleteErr   error
+	memo        *commonv1.Memo
+}
+
+func (s *fakeWorkflowService) DescribeSchedule(context.Context, *workflowservice.DescribeScheduleRequest) (*workflowservice.DescribeScheduleResponse, error) {
+	return &workflowservice.DescribeScheduleResponse{Memo: s.memo}, statusError(s.describeErr)
+}
+
+func (s *fakeWorkflowService) UpdateSchedule(context.Context, *workflowservice.UpdateScheduleRequest) (*workflowservice.UpdateScheduleResponse, error) {
+	return &workflowservice.UpdateScheduleResponse{}, statusError(s.updateErr)
+}
+
+func (s *fakeWorkflowService) DeleteSchedule(context.Context, *workflowservice.DeleteScheduleRequest) (*workflowservice.DeleteScheduleResponse, error) {
+	return &workflowservice.DeleteScheduleResponse{}, statusError(s.deleteErr)
+}
+
+// statusError encodes a service error the way the Temporal server sends it.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return serviceerror.ToStatus(err).Err()
+}
+
+// startFakeWorkflowService serves srv in process and returns a client connection to it, so
+// errors reach the provider the way they do from a real server.
+func startFakeWorkflowService(t *testing.T, srv workflowservice.WorkflowServiceServer) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	workflowservice.RegisterWorkflowServiceServer(server, srv)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+// updateScheduleError returns the error of an UpdateSchedule call answered with err.
+func updateScheduleError(t *testing.T, err error) error {
+	t.Helper()
+	conn := startFakeWorkflowService(t, &fakeWorkflowService{updateErr: err})
+	_, err = workflowservice.NewWorkflowServiceClient(conn).UpdateSchedule(context.Background(), &workflowservice.UpdateScheduleRequest{})
+	return err
+}
+
+func TestToServiceError(t *testing.T) {
+	err := updateScheduleError(t, serviceerror.NewAlreadyExists("schedule already exists"))
+
+	if _, ok := err.(*serviceerror.AlreadyExists); ok {
+		t.Fatal("expected the client to return a gRPC status error")
+	}
+	if _, ok := toServiceError(err).(*serviceerror.AlreadyExists); !ok {
+		t.Errorf("expected an AlreadyExists service error, got %T", toServiceError(err))
+	}
+	if toServiceError(nil) != nil {
+		t.Error("expected no error for a nil error")
+	}
+}
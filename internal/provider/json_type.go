@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = jsonType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+)
+
+// jsonType is a string type holding a JSON document.
+type jsonType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is a jsonType.
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type.
+func (t jsonType) String() string {
+	return "provider.jsonType"
+}
+
+// ValueFromString wraps a string value into a jsonValue.
+func (t jsonType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value into a jsonValue.
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return jsonValue{StringValue: stringValue}, nil
+}
+
+// ValueType returns the value type of jsonType.
+func (t jsonType) ValueType(_ context.Context) attr.Value {
+	return jsonValue{}
+}
+
+// jsonValue is a JSON document. Documents that only differ in whitespace or
+// object key order are semantically equal and do not produce a diff.
+type jsonValue struct {
+	basetypes.StringValue
+}
+
+// jsonNull returns a null jsonValue.
+func jsonNull() jsonValue {
+	return jsonValue{StringValue: basetypes.NewStringNull()}
+}
+
+// jsonStringValue returns a known jsonValue.
+func jsonStringValue(value string) jsonValue {
+	return jsonValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Equal returns true if the given value is a jsonValue with the same string.
+func (v jsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns jsonType.
+func (v jsonValue) Type(_ context.Context) attr.Type {
+	return jsonType{}
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON document.
+func (v jsonValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return jsonSemanticEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// jsonSemanticEqual reports whether two strings are valid JSON encoding the same document.
+// Numbers are compared exactly, so large integers such as IDs that differ beyond float64
+// precision are not equal.
+func jsonSemanticEqual(a, b string) bool {
+	decodedA, ok := decodeJSONNumbers(a)
+	if !ok {
+		return false
+	}
+	decodedB, ok := decodeJSONNumbers(b)
+	if !ok {
+		return false
+	}
+
+	return jsonValuesEqual(decodedA, decodedB)
+}
+
+// decodeJSONNumbers decodes a JSON document, keeping numbers as json.Number.
+func decodeJSONNumbers(s string) (interface{}, bool) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, false
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+
+	return decoded, true
+}
+
+// jsonValuesEqual reports whether two decoded JSON values are equal. Numbers are equal when
+// they have the same exact value, whatever their notation, e.g. 1, 1.0 and 1e0.
+func jsonValuesEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if a == b {
+			return true
+		}
+		numberA, okA := parseJSONNumber(a)
+		numberB, okB := parseJSONNumber(b)
+		return okA && okB && numberA.Cmp(numberB) == 0
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// parseJSONNumber parses a JSON number with enough precision to hold all of its digits.
+func parseJSONNumber(n json.Number) (*big.Float, bool) {
+	// Each decimal digit needs less than 4 bits of mantissa.
+	prec := uint(4*len(n) + 64)
+	f, _, err := big.ParseFloat(n.String(), 10, prec, big.ToNearestEven)
+	return f, err == nil
+}
+
+// validJSON returns a validator that checks that a string is a valid JSON document.
+func validJSON() validator.String {
+	return jsonValidator{}
+}
+
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Value must be a valid JSON document, e.g. built with jsonencode(), got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+// TestJSONValue_SemanticEquals verifies that whitespace, key order and number notation do
+// not make JSON documents differ, while different values do, including large integers.
+func TestJSONValue_SemanticEquals(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"a":1,"b":[true,null]}`, "{\n  \"b\": [true, null],\n  \"a\": 1\n}", true},
+		{`"x"`, ` "x" `, true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`[1,2]`, `[2,1]`, false},
+		{`{"a":1}`, `not json`, false},
+		{`{"a":1}`, `{"a":1} {"a":1}`, false},
+		{`{"id":9007199254740993}`, `{"id":9007199254740992}`, false},
+		{`{"id":12345678901234567890}`, `{"id":12345678901234567890}`, true},
+		{`[1, 1.0, 100]`, `[1.0, 1, 1e2]`, true},
+		{`0.1`, `0.10000000000000001`, false},
+	}
+
+	for _, c := range cases {
+		got, diags := jsonStringValue(c.a).StringSemanticEquals(context.Background(), jsonStringValue(c.b))
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+		if got != c.want {
+			t.Errorf("StringSemanticEquals(%s, %s): got %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// grpcLogSubsystem is the tflog subsystem used for Temporal API calls.
+	// Its level can be set independently with TF_LOG_PROVIDER_TEMPORAL_GRPC.
+	grpcLogSubsystem = "grpc"
+
+	// redactedValue replaces sensitive values in logged requests and responses.
+	redactedValue = "***"
+)
+
+// redactedLogFieldKeys lists field keys whose values never appear in logs: OAuth credentials,
+// memo payloads, workflow inputs and headers. They are masked as log fields and, at any depth,
+// inside rendered requests and responses.
+var redactedLogFieldKeys = []string{
+	"authorization",
+	"access_token",
+	"client_secret",
+	"memo",
+	"input",
+	"header",
+}
+
+// loggingUnaryInterceptor logs every Temporal API call through the gRPC tflog subsystem.
+// The method, namespace, duration and status code are logged at DEBUG level; the
+// protojson-rendered request and response are added at TRACE level with secrets redacted.
+func loggingUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = newGRPCLogContext(ctx)
+
+	fields := map[string]interface{}{
+		"grpc_method": method,
+	}
+	if ns := requestNamespace(req); ns != "" {
+		fields["namespace"] = ns
+	}
+
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	fields["grpc_code"] = status.Code(err).String()
+	if err != nil {
+		fields["error"] = status.Convert(err).Message()
+	}
+
+	tflog.SubsystemDebug(ctx, grpcLogSubsystem, "Temporal API call", fields)
+
+	if tflog.SubsystemIsTrace(ctx, grpcLogSubsystem) {
+		traceFields := map[string]interface{}{
+			"grpc_method": method,
+			"request":     renderRedacted(req),
+		}
+		if err == nil {
+			traceFields["response"] = renderRedacted(reply)
+		}
+		tflog.SubsystemTrace(ctx, grpcLogSubsystem, "Temporal API payloads", traceFields)
+	}
+
+	return err
+}
+
+// newGRPCLogContext creates the gRPC log subsystem and configures masking, including
+// the bearer token attached to the outgoing request by the OAuth interceptor.
+func newGRPCLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, grpcLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_TEMPORAL", grpcLogSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, grpcLogSubsystem, redactedLogFieldKeys...)
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			token := strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+			if token != "" {
+				ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, grpcLogSubsystem, token)
+				ctx = tflog.SubsystemMaskMessageStrings(ctx, grpcLogSubsystem, token)
+			}
+		}
+	}
+
+	return ctx
+}
+
+// requestNamespace returns the value of the top-level "namespace" field of a request, if any.
+func requestNamespace(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	field := msg.ProtoReflect().Descriptor().Fields().ByName("namespace")
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return msg.ProtoReflect().Get(field).String()
+}
+
+// renderRedacted renders a protobuf message with protojson and replaces the values of
+// redactedLogFieldKeys at any depth.
+func renderRedacted(v interface{}) interface{} {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return "unable to render message: " + err.Error()
+	}
+
+	var rendered interface{}
+	if err := json.Unmarshal(data, &rendered); err != nil {
+		return "unable to render message: " + err.Error()
+	}
+
+	return redactFields(rendered)
+}
+
+// redactFields walks a decoded JSON value and masks every object key listed in redactedLogFieldKeys.
+func redactFields(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, nested := range value {
+			if isRedactedFieldKey(k) {
+				value[k] = redactedValue
+				continue
+			}
+			value[k] = redactFields(nested)
+		}
+	case []interface{}:
+		for i, nested := range value {
+			value[i] = redactFields(nested)
+		}
+	}
+
+	return v
+}
+
+// isRedactedFieldKey reports whether a field key, in protojson camelCase or snake_case, is redacted.
+func isRedactedFieldKey(key string) bool {
+	for _, k := range redactedLogFieldKeys {
+		if strings.EqualFold(key, k) || strings.EqualFold(key, strings.ReplaceAll(k, "_", "")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	commonv1 "go.temporal.io/api/common/v1"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	workflowv1 "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func secretScheduleRequest() *workflowservice.CreateScheduleRequest {
+	return &workflowservice.CreateScheduleRequest{
+		Namespace:  "payments",
+		ScheduleId: "nightly",
+		Memo: &commonv1.Memo{Fields: map[string]*commonv1.Payload{
+			"owner": {Data: []byte(`"memo-secret"`)},
+		}},
+		Schedule: &schedulev1.Schedule{
+			Action: &schedulev1.ScheduleAction{
+				Action: &schedulev1.ScheduleAction_StartWorkflow{
+					StartWorkflow: &workflowv1.NewWorkflowExecutionInfo{
+						WorkflowId: "wf",
+						Input: &commonv1.Payloads{Payloads: []*commonv1.Payload{
+							{Data: []byte(`"input-secret"`)},
+						}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestLoggingUnaryInterceptor_RedactsSecrets verifies that the interceptor logs the
+// method, namespace and status code, and that memo payloads, workflow inputs and the
+// OAuth bearer token never reach the log output.
+func TestLoggingUnaryInterceptor_RedactsSecrets(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_TEMPORAL_GRPC", "TRACE")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer token-secret")
+
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return nil
+	}
+	err := loggingUnaryInterceptor(ctx, "/temporal.api.workflowservice.v1.WorkflowService/CreateSchedule",
+		secretScheduleRequest(), &workflowservice.CreateScheduleResponse{}, nil, invoker)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw := output.String()
+	for _, secret := range []string{"token-secret", `"memo-secret"`, `"input-secret"`} {
+		// Payload data is rendered as base64 by protojson.
+		if strings.Contains(raw, secret) || strings.Contains(raw, base64.StdEncoding.EncodeToString([]byte(secret))) {
+			t.Errorf("log output leaks %q: %s", secret, raw)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decode log output: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected a debug and a trace entry, got %d: %v", len(entries), entries)
+	}
+	if entries[0]["namespace"] != "payments" {
+		t.Errorf("namespace: got %v, want %q", entries[0]["namespace"], "payments")
+	}
+	if entries[0]["grpc_code"] != "OK" {
+		t.Errorf("grpc_code: got %v, want %q", entries[0]["grpc_code"], "OK")
+	}
+	if entries[1]["@level"] != "trace" || entries[1]["request"] == nil {
+		t.Errorf("expected a trace entry with the rendered request, got %v", entries[1])
+	}
+}
+
+// TestRenderRedacted_NestedFields verifies that sensitive keys are masked at any depth.
+func TestRenderRedacted_NestedFields(t *testing.T) {
+	rendered, err := json.Marshal(renderRedacted(secretScheduleRequest()))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	out := string(rendered)
+	for _, secret := range []string{`"memo-secret"`, `"input-secret"`} {
+		if strings.Contains(out, base64.StdEncoding.EncodeToString([]byte(secret))) {
+			t.Errorf("rendered request leaks %q: %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, `"memo":"***"`) || !strings.Contains(out, `"input":"***"`) {
+		t.Errorf("rendered request must mask memo and input: %s", out)
+	}
+	if !strings.Contains(out, `"scheduleId":"nightly"`) {
+		t.Errorf("rendered request must keep non-sensitive fields: %s", out)
+	}
+}
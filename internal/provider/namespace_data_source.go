@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.temporal.io/api/workflowservice/v1"
-	"google.golang.org/grpc"
 )
 
 // Ensures that NamespaceDataSource fully satisfies the datasource.DataSource and
@@ -123,17 +122,17 @@ func (d *NamespaceDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	connection, ok := req.ProviderData.(grpc.ClientConnInterface)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	client := workflowservice.NewWorkflowServiceClient(connection)
+	client := workflowservice.NewWorkflowServiceClient(providerData.Conn)
 	d.client = client
 
 	tflog.Info(ctx, "Configured Temporal Namespace client", map[string]any{"success": true})
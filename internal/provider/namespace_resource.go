@@ -47,7 +47,8 @@ func NewNamespaceResource() resource.Resource {
 
 // NamespaceResource a Temporal namespace resource implementation.
 type NamespaceResource struct {
-	client grpc.ClientConnInterface
+	client   grpc.ClientConnInterface
+	readOnly bool
 }
 
 // NamespaceResourceModel defines the data schema for a Temporal namespace resource.
@@ -158,23 +159,28 @@ func (r *NamespaceResource) Configure(ctx context.Context, req resource.Configur
 	}
 
 	tflog.Info(ctx, "Configured Temporal Namespace client", map[string]any{"success": true})
-	client, ok := req.ProviderData.(grpc.ClientConnInterface)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Conn
+	r.readOnly = providerData.ReadOnly
 
 	tflog.Info(ctx, "Configured Temporal Namespace client", map[string]any{"success": true})
 }
 
 // Create is responsible for creating a new namespace in Temporal.
 func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "create the namespace", &resp.Diagnostics) {
+		return
+	}
+
 	var data NamespaceResourceModel
 
 	client := workflowservice.NewWorkflowServiceClient(r.client)
@@ -285,6 +291,10 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update modifies an existing Temporal namespace based on Terraform configuration changes.
 func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "update the namespace", &resp.Diagnostics) {
+		return
+	}
+
 	var data NamespaceResourceModel
 
 	client := workflowservice.NewWorkflowServiceClient(r.client)
@@ -416,6 +426,10 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Delete removes a Temporal namespace from both Temporal and the Terraform state.
 func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "delete the namespace", &resp.Diagnostics) {
+		return
+	}
+
 	var data NamespaceResourceModel
 
 	client := operatorservice.NewOperatorServiceClient(r.client)
@@ -13,8 +13,11 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -22,23 +25,48 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/oauth2/clientcredentials"
 	"google.golang.org/grpc"
 	grpcCreds "google.golang.org/grpc/credentials"
 	grpcInsec "google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 )
 
 // TemporalProvider implements the provider interface for Temporal.
 // It is used to configure and manage Temporal resources.
-var _ provider.Provider = &TemporalProvider{}
+var (
+	_ provider.Provider            = &TemporalProvider{}
+	_ provider.ProviderWithActions = &TemporalProvider{}
+)
 
 // TemporalProvider defines the structure for the Temporal provider.
 type TemporalProvider struct {
 	version string
 }
 
+const (
+	// defaultNamespace is used by namespaced resources when neither they nor the provider set a namespace.
+	defaultNamespace = "default"
+)
+
+// TemporalProviderData is passed to resources and data sources during their Configure calls.
+type TemporalProviderData struct {
+	// Conn is the gRPC connection to the Temporal frontend.
+	Conn grpc.ClientConnInterface
+	// Namespace is used by namespaced resources and data sources that omit their own namespace.
+	Namespace string
+	// DefaultMemo is merged into the memo of every schedule managed by the provider.
+	DefaultMemo map[string]string
+	// ReadOnly rejects every resource Create, Update and Delete before any RPC is issued.
+	ReadOnly bool
+	// PayloadCodec encodes and decodes schedule payloads. It is nil when no codec is configured.
+	PayloadCodec *payloadCodec
+}
+
 // temporalProviderModel defines the configuration structure for the Temporal provider.
 // It includes the host and port for connecting to the Temporal server.
 type temporalProviderModel struct {
@@ -51,6 +79,29 @@ type temporalProviderModel struct {
 	Scopes       types.List   `tfsdk:"scopes"`
 	Insecure     types.Bool   `tfsdk:"insecure"`
 	TLS          types.Object `tfsdk:"tls"`
+	PayloadCodec types.Object `tfsdk:"payload_codec"`
+	Namespace    types.String `tfsdk:"namespace"`
+	DefaultMemo  types.Map    `tfsdk:"default_memo"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+
+	MaxRecvMsgSize   types.Int64  `tfsdk:"max_recv_msg_size"`
+	KeepaliveTime    types.String `tfsdk:"keepalive_time"`
+	KeepaliveTimeout types.String `tfsdk:"keepalive_timeout"`
+	GRPCCompression  types.String `tfsdk:"grpc_compression"`
+}
+
+// payloadCodecModel defines the payload_codec block of the provider configuration.
+type payloadCodecModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Auth     types.String `tfsdk:"auth"`
+}
+
+// grpcOptions holds the transport tuning options applied to the gRPC connection.
+type grpcOptions struct {
+	MaxRecvMsgSize   int
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	Compression      string
 }
 
 // Metadata assigns the provider's name and version.
@@ -88,6 +139,21 @@ func (p *TemporalProvider) Schema(ctx context.Context, req provider.SchemaReques
 					},
 				},
 			},
+			"payload_codec": schema.SingleNestedBlock{
+				Description: "Remote codec server used to encode schedule workflow inputs, memos and headers, " +
+					"e.g. to match the encryption codec of the workers",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Optional:    true,
+						Description: "Codec server URL serving the /encode and /decode endpoints",
+					},
+					"auth": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Authorization header value sent to the codec server",
+					},
+				},
+			},
 		},
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
@@ -140,6 +206,44 @@ func (p *TemporalProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Description: "Use insecure connection",
 			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Namespace used by namespaced resources and data sources that do not set their own namespace. Defaults to 'default'.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reject every resource create, update and delete before contacting Temporal. Reads and data sources keep working, so plans can run with credentials that must not mutate the cluster.",
+			},
+			"default_memo": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Memo entries added to every schedule managed by the provider. Entries set on a schedule's own memo take precedence.",
+			},
+			"max_recv_msg_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum size in bytes of a gRPC message the provider can receive. Defaults to the gRPC default of 4MB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keepalive_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Interval after which a keepalive ping is sent on an idle connection (e.g. '30s'). Keepalive is disabled when unset.",
+			},
+			"keepalive_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time to wait for a keepalive ping acknowledgement before closing the connection (e.g. '10s'). Requires keepalive_time. Defaults to 20s.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("keepalive_time")),
+				},
+			},
+			"grpc_compression": schema.StringAttribute{
+				Optional:    true,
+				Description: "Compressor used for gRPC requests. Accepted values: gzip",
+				Validators: []validator.String{
+					stringvalidator.OneOf(gzip.Name),
+				},
+			},
 		},
 	}
 }
@@ -225,6 +329,88 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_INSECURE environment variable.",
 		)
 	}
+	if config.Namespace.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("namespace"),
+			"Unknown Temporal Namespace",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the default Temporal namespace. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_NAMESPACE environment variable.",
+		)
+	}
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown Read Only",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the Read Only option. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_READ_ONLY environment variable.",
+		)
+	}
+	if config.DefaultMemo.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_memo"),
+			"Unknown Default Memo",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the default memo. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+	if config.MaxRecvMsgSize.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_recv_msg_size"),
+			"Unknown Max Receive Message Size",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the gRPC max receive message size. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_MAX_RECV_MSG_SIZE environment variable.",
+		)
+	}
+	if config.KeepaliveTime.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keepalive_time"),
+			"Unknown Keepalive Time",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the gRPC keepalive time. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_KEEPALIVE_TIME environment variable.",
+		)
+	}
+	if config.KeepaliveTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keepalive_timeout"),
+			"Unknown Keepalive Timeout",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the gRPC keepalive timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_KEEPALIVE_TIMEOUT environment variable.",
+		)
+	}
+	if config.GRPCCompression.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grpc_compression"),
+			"Unknown gRPC Compression",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the gRPC compression. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_GRPC_COMPRESSION environment variable.",
+		)
+	}
+	codecConfig := payloadCodecModel{
+		Endpoint: types.StringNull(),
+		Auth:     types.StringNull(),
+	}
+	if !config.PayloadCodec.IsNull() && !config.PayloadCodec.IsUnknown() {
+		resp.Diagnostics.Append(config.PayloadCodec.As(ctx, &codecConfig, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if config.PayloadCodec.IsUnknown() || codecConfig.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payload_codec").AtName("endpoint"),
+			"Unknown Payload Codec Endpoint",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the payload codec endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_CODEC_ENDPOINT environment variable.",
+		)
+	}
+	if codecConfig.Auth.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payload_codec").AtName("auth"),
+			"Unknown Payload Codec Auth",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the payload codec auth. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_CODEC_AUTH environment variable.",
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -238,6 +424,7 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 	clientSecret := os.Getenv("TEMPORAL_CLIENT_SECRET")
 	audience := os.Getenv("TEMPORAL_AUDIENCE")
 	scopes := parseScopesEnv(os.Getenv("TEMPORAL_SCOPES"))
+	namespace := os.Getenv("TEMPORAL_NAMESPACE")
 	insecure, err := getBoolEnv("TEMPORAL_INSECURE")
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -248,6 +435,29 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	readOnly, err := getBoolEnv("TEMPORAL_READ_ONLY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown Read Only",
+			"The provider cannot create the Temporal API client as there is an unknown configuration value for the Read Only option. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TEMPORAL_READ_ONLY environment variable.",
+		)
+	}
+	maxRecvMsgSize, err := getIntEnv("TEMPORAL_MAX_RECV_MSG_SIZE")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_recv_msg_size"),
+			"Invalid Max Receive Message Size",
+			"The TEMPORAL_MAX_RECV_MSG_SIZE environment variable must be an integer number of bytes: "+err.Error(),
+		)
+	}
+	keepaliveTime := os.Getenv("TEMPORAL_KEEPALIVE_TIME")
+	keepaliveTimeout := os.Getenv("TEMPORAL_KEEPALIVE_TIMEOUT")
+	compression := os.Getenv("TEMPORAL_GRPC_COMPRESSION")
+	codecEndpoint := os.Getenv("TEMPORAL_CODEC_ENDPOINT")
+	codecAuth := os.Getenv("TEMPORAL_CODEC_AUTH")
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -285,6 +495,93 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 	if !config.Insecure.IsNull() {
 		insecure = config.Insecure.ValueBool()
 	}
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+	if !config.Namespace.IsNull() {
+		namespace = config.Namespace.ValueString()
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	defaultMemo := make(map[string]string)
+	if !config.DefaultMemo.IsNull() {
+		resp.Diagnostics.Append(config.DefaultMemo.ElementsAs(ctx, &defaultMemo, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !config.MaxRecvMsgSize.IsNull() {
+		maxRecvMsgSize = int(config.MaxRecvMsgSize.ValueInt64())
+	}
+	if !config.KeepaliveTime.IsNull() {
+		keepaliveTime = config.KeepaliveTime.ValueString()
+	}
+	if !config.KeepaliveTimeout.IsNull() {
+		keepaliveTimeout = config.KeepaliveTimeout.ValueString()
+	}
+	if !config.GRPCCompression.IsNull() {
+		compression = config.GRPCCompression.ValueString()
+	}
+	if !codecConfig.Endpoint.IsNull() {
+		codecEndpoint = codecConfig.Endpoint.ValueString()
+	}
+	if !codecConfig.Auth.IsNull() {
+		codecAuth = codecConfig.Auth.ValueString()
+	}
+
+	grpcOpts := grpcOptions{
+		MaxRecvMsgSize: maxRecvMsgSize,
+		Compression:    compression,
+	}
+	if keepaliveTime != "" {
+		grpcOpts.KeepaliveTime, err = time.ParseDuration(keepaliveTime)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("keepalive_time"),
+				"Invalid Keepalive Time",
+				fmt.Sprintf("Unable to parse keepalive time: %s. Error: %s", keepaliveTime, err),
+			)
+		}
+	}
+	if keepaliveTimeout != "" {
+		grpcOpts.KeepaliveTimeout, err = time.ParseDuration(keepaliveTimeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("keepalive_timeout"),
+				"Invalid Keepalive Timeout",
+				fmt.Sprintf("Unable to parse keepalive timeout: %s. Error: %s", keepaliveTimeout, err),
+			)
+		}
+	}
+	if keepaliveTimeout != "" && keepaliveTime == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keepalive_timeout"),
+			"Missing Keepalive Time",
+			"The keepalive timeout only applies to keepalive pings, which are sent when keepalive_time or the TEMPORAL_KEEPALIVE_TIME environment variable is set.",
+		)
+	}
+	if compression != "" && compression != gzip.Name {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grpc_compression"),
+			"Invalid gRPC Compression",
+			fmt.Sprintf("Unsupported gRPC compression: %s. Accepted values: %s", compression, gzip.Name),
+		)
+	}
+	var codec *payloadCodec
+	if codecEndpoint != "" {
+		if u, err := url.Parse(codecEndpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("payload_codec").AtName("endpoint"),
+				"Invalid Payload Codec Endpoint",
+				fmt.Sprintf("The payload codec endpoint must be an http or https URL, got: %s", codecEndpoint),
+			)
+		}
+		codec = newPayloadCodec(codecEndpoint, codecAuth)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var (
 		certString string
@@ -325,7 +622,8 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 
 	tflog.Debug(ctx, "Creating Temporal client")
 	tflog.Debug(ctx, "Use TLS? "+strconv.FormatBool(useTLS))
-	client, err := CreateGRPCClient(clientID, clientSecret, tokenURL, audience, scopes, endpoint, insecure, useTLS, certString, keyString, caCerts, serverName)
+	dialOpts := append(grpcOpts.dialOptions(), grpc.WithChainUnaryInterceptor(loggingUnaryInterceptor))
+	client, err := CreateGRPCClient(clientID, clientSecret, tokenURL, audience, scopes, endpoint, insecure, useTLS, certString, keyString, caCerts, serverName, dialOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Temporal API Client",
@@ -338,8 +636,16 @@ func (p *TemporalProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Make the Temporal client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &TemporalProviderData{
+		Conn:         client,
+		Namespace:    namespace,
+		DefaultMemo:  defaultMemo,
+		ReadOnly:     readOnly,
+		PayloadCodec: codec,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.ActionData = providerData
 
 	tflog.Info(ctx, "Configured Temporal client", map[string]any{"success": true})
 }
@@ -358,6 +664,17 @@ func (p *TemporalProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		NewNamespaceDataSource,
 		NewSearchAttributeDataSource,
+		NewScheduleDataSource,
+		NewSchedulesDataSource,
+		NewSchedulePreviewDataSource,
+	}
+}
+
+// Actions returns a list of action types invoked by this provider.
+func (p *TemporalProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewScheduleTriggerAction,
+		NewScheduleBackfillAction,
 	}
 }
 
@@ -373,7 +690,7 @@ func New(version string) func() provider.Provider {
 
 // CreateAuthenticatedClient creates a gRPC client with OAuth authentication.
 // It uses a TokenSource so the token is automatically refreshed when it expires.
-func CreateAuthenticatedClient(endpoint string, clientID, clientSecret, tokenURL, audience string, scopes []string, credentials grpcCreds.TransportCredentials) (*grpc.ClientConn, error) {
+func CreateAuthenticatedClient(endpoint string, clientID, clientSecret, tokenURL, audience string, scopes []string, credentials grpcCreds.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	cfg := clientcredentials.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
@@ -385,7 +702,7 @@ func CreateAuthenticatedClient(endpoint string, clientID, clientSecret, tokenURL
 	}
 	ts := cfg.TokenSource(context.Background())
 
-	return grpc.NewClient(endpoint, grpc.WithTransportCredentials(credentials), grpc.WithUnaryInterceptor(
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(credentials), grpc.WithUnaryInterceptor(
 		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 			token, err := ts.Token()
 			if err != nil {
@@ -394,21 +711,24 @@ func CreateAuthenticatedClient(endpoint string, clientID, clientSecret, tokenURL
 			newCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token.AccessToken)
 			return invoker(newCtx, method, req, reply, cc, opts...)
 		},
-	))
+	)}, opts...)
+
+	return grpc.NewClient(endpoint, opts...)
 }
 
 // CreateSecureClient creates a gRPC client using mTLS without OAuth authentication.
-func CreateSecureClient(endpoint string, credentials grpcCreds.TransportCredentials) (*grpc.ClientConn, error) {
-	return grpc.NewClient(endpoint, grpc.WithTransportCredentials(credentials))
+func CreateSecureClient(endpoint string, credentials grpcCreds.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.NewClient(endpoint, append([]grpc.DialOption{grpc.WithTransportCredentials(credentials)}, opts...)...)
 }
 
 // CreateInsecureClient creates a gRPC client without any authentication.
-func CreateInsecureClient(endpoint string, credentials grpcCreds.TransportCredentials) (*grpc.ClientConn, error) {
-	return grpc.NewClient(endpoint, grpc.WithTransportCredentials(credentials))
+func CreateInsecureClient(endpoint string, credentials grpcCreds.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.NewClient(endpoint, append([]grpc.DialOption{grpc.WithTransportCredentials(credentials)}, opts...)...)
 }
 
 // CreateGRPCClient decides which gRPC client to create based on clientID.
-func CreateGRPCClient(clientID, clientSecret, tokenURL, audience string, scopes []string, endpoint string, insecure bool, useTLS bool, certString string, keyString string, caCerts string, serverName string) (*grpc.ClientConn, error) {
+// Additional dial options, such as those built by grpcOptions, are applied to the connection.
+func CreateGRPCClient(clientID, clientSecret, tokenURL, audience string, scopes []string, endpoint string, insecure bool, useTLS bool, certString string, keyString string, caCerts string, serverName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	var credentials grpcCreds.TransportCredentials
 
 	switch insecure {
@@ -440,12 +760,41 @@ func CreateGRPCClient(clientID, clientSecret, tokenURL, audience string, scopes
 	}
 
 	if clientID != "" {
-		return CreateAuthenticatedClient(endpoint, clientID, clientSecret, tokenURL, audience, scopes, credentials)
+		return CreateAuthenticatedClient(endpoint, clientID, clientSecret, tokenURL, audience, scopes, credentials, opts...)
 	} else if useTLS {
-		return CreateSecureClient(endpoint, credentials)
+		return CreateSecureClient(endpoint, credentials, opts...)
 	}
 
-	return CreateInsecureClient(endpoint, credentials)
+	return CreateInsecureClient(endpoint, credentials, opts...)
+}
+
+// dialOptions converts the transport tuning options into gRPC dial and default call options.
+// Zero values leave the corresponding gRPC defaults untouched.
+func (o grpcOptions) dialOptions() []grpc.DialOption {
+	var (
+		opts     []grpc.DialOption
+		callOpts []grpc.CallOption
+	)
+
+	if o.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(o.MaxRecvMsgSize))
+	}
+	if o.Compression != "" {
+		callOpts = append(callOpts, grpc.UseCompressor(o.Compression))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	if o.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                o.KeepaliveTime,
+			Timeout:             o.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	return opts
 }
 
 // Function to get CA certificates.
@@ -485,6 +834,14 @@ func getBoolEnv(key string) (result bool, err error) {
 	return result, err
 }
 
+func getIntEnv(key string) (result int, err error) {
+	val, exist := os.LookupEnv(key)
+	if !exist || val == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(val)
+}
+
 // Helper function to strip quotes and remove line return escaping from cert.
 func normalizeCert(value string) string {
 	return strings.ReplaceAll(stripQuotes(value), "\\n", "\n")
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// compressionRecorder captures the compression of incoming request headers.
+type compressionRecorder struct {
+	mu          sync.Mutex
+	compression string
+}
+
+func (c *compressionRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (c *compressionRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if h, ok := s.(*stats.InHeader); ok {
+		c.mu.Lock()
+		c.compression = h.Compression
+		c.mu.Unlock()
+	}
+}
+
+func (c *compressionRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (c *compressionRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+// newStubGRPCServer starts a server answering every method with a response of respSize bytes.
+func newStubGRPCServer(t *testing.T, respSize int, opts ...grpc.ServerOption) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	opts = append(opts, grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+			return err
+		}
+		return stream.SendMsg(wrapperspb.Bytes(make([]byte, respSize)))
+	}))
+	srv := grpc.NewServer(opts...)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestCreateGRPCClient_MaxRecvMsgSize(t *testing.T) {
+	const respSize = 5 * 1024 * 1024
+	endpoint := newStubGRPCServer(t, respSize)
+
+	invoke := func(opts grpcOptions) error {
+		conn, err := CreateGRPCClient("", "", "", "", nil, endpoint, true, false, "", "", "", "", opts.dialOptions()...)
+		if err != nil {
+			t.Fatalf("CreateGRPCClient: %v", err)
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return conn.Invoke(ctx, "/no.svc/Method", &emptypb.Empty{}, &wrapperspb.BytesValue{})
+	}
+
+	if err := invoke(grpcOptions{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted with the default limit, got %v", err)
+	}
+	if err := invoke(grpcOptions{MaxRecvMsgSize: 2 * respSize}); err != nil {
+		t.Errorf("expected response to fit into the raised limit, got %v", err)
+	}
+}
+
+func TestCreateGRPCClient_Compression(t *testing.T) {
+	recorder := &compressionRecorder{}
+	endpoint := newStubGRPCServer(t, 0, grpc.StatsHandler(recorder))
+
+	opts := grpcOptions{
+		Compression:      "gzip",
+		KeepaliveTime:    30 * time.Second,
+		KeepaliveTimeout: 10 * time.Second,
+	}
+	conn, err := CreateGRPCClient("", "", "", "", nil, endpoint, true, false, "", "", "", "", opts.dialOptions()...)
+	if err != nil {
+		t.Fatalf("CreateGRPCClient: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conn.Invoke(ctx, "/no.svc/Method", &emptypb.Empty{}, &wrapperspb.BytesValue{}); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if recorder.compression != "gzip" {
+		t.Errorf("request compression: got %q, want %q", recorder.compression, "gzip")
+	}
+}
+
+func TestConfigure_KeepaliveTimeoutRequiresTime(t *testing.T) {
+	t.Setenv("TEMPORAL_KEEPALIVE_TIME", "")
+	t.Setenv("TEMPORAL_KEEPALIVE_TIMEOUT", "")
+	ctx := context.Background()
+	p := &TemporalProvider{}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["keepalive_timeout"] = tftypes.NewValue(tftypes.String, "10s")
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+
+	for _, d := range resp.Diagnostics {
+		if d.Summary() == "Missing Keepalive Time" {
+			return
+		}
+	}
+	t.Errorf("expected a missing keepalive time error, got %v", resp.Diagnostics)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rejectReadOnly adds an error diagnostic and returns true when the provider is read-only.
+// Resources and actions call it before issuing any mutating RPC; operation describes the
+// rejected change, e.g. "create the schedule".
+func rejectReadOnly(ctx context.Context, readOnly bool, operation string, diags *diag.Diagnostics) bool {
+	if !readOnly {
+		return false
+	}
+
+	tflog.Warn(ctx, "Rejected change in read-only mode", map[string]any{"operation": operation})
+	diags.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("Cannot %s because the provider is configured with read_only = true. "+
+			"Unset read_only (or TEMPORAL_READ_ONLY) to apply changes.", operation),
+	)
+	return true
+}
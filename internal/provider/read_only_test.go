@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// TestResources_RejectWritesWhenReadOnly verifies that every resource rejects writes
+// before reading its plan or contacting Temporal when the provider is read-only.
+func TestResources_RejectWritesWhenReadOnly(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range (&TemporalProvider{}).Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "temporal"}, &metadata)
+
+		r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+			ProviderData: &TemporalProviderData{ReadOnly: true},
+		}, &resource.ConfigureResponse{})
+
+		createResp := &resource.CreateResponse{}
+		r.Create(ctx, resource.CreateRequest{}, createResp)
+		updateResp := &resource.UpdateResponse{}
+		r.Update(ctx, resource.UpdateRequest{}, updateResp)
+		deleteResp := &resource.DeleteResponse{}
+		r.Delete(ctx, resource.DeleteRequest{}, deleteResp)
+
+		for op, diags := range map[string]diag.Diagnostics{
+			"create": createResp.Diagnostics,
+			"update": updateResp.Diagnostics,
+			"delete": deleteResp.Diagnostics,
+		} {
+			if !diags.HasError() || diags[0].Summary() != "Provider Is Read-Only" {
+				t.Errorf("%s %s: expected read-only error, got %v", metadata.TypeName, op, diags)
+			}
+		}
+	}
+}
+
+// TestRejectReadOnly verifies that changes are only rejected in read-only mode.
+func TestRejectReadOnly(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	if rejectReadOnly(ctx, false, "create the schedule", &diags) || diags.HasError() {
+		t.Errorf("expected no rejection without read-only, got %v", diags)
+	}
+	if !rejectReadOnly(ctx, true, "create the schedule", &diags) || !diags.HasError() {
+		t.Error("expected a rejection in read-only mode")
+	}
+}
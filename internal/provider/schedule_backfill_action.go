@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ensures that ScheduleBackfillAction fully satisfies the action.Action,
+// action.ActionWithConfigure and action.ActionWithValidateConfig interfaces.
+var (
+	_ action.Action                   = &ScheduleBackfillAction{}
+	_ action.ActionWithConfigure      = &ScheduleBackfillAction{}
+	_ action.ActionWithValidateConfig = &ScheduleBackfillAction{}
+)
+
+// NewScheduleBackfillAction returns a new instance of the ScheduleBackfillAction.
+func NewScheduleBackfillAction() action.Action {
+	return &ScheduleBackfillAction{}
+}
+
+// ScheduleBackfillAction takes the actions an existing schedule would have taken in a past window.
+type ScheduleBackfillAction struct {
+	scheduleActionClient
+}
+
+// ScheduleBackfillActionModel defines the configuration of the backfill action.
+type ScheduleBackfillActionModel struct {
+	Namespace     types.String `tfsdk:"namespace"`
+	ScheduleID    types.String `tfsdk:"schedule_id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	OverlapPolicy types.String `tfsdk:"overlap_policy"`
+}
+
+// Metadata sets the metadata for the Temporal schedule backfill action, specifically the type name.
+func (a *ScheduleBackfillAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_backfill"
+}
+
+// Schema defines the schema for the Temporal schedule backfill action.
+func (a *ScheduleBackfillAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes the actions an existing schedule would have taken between `start_time` and `end_time`, e.g. to backfill a missed window",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace":   scheduleActionNamespaceAttribute(),
+			"schedule_id": scheduleActionScheduleIDAttribute(),
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Start of the window to backfill (RFC3339)",
+				Required:            true,
+				Validators:          []validator.String{validRFC3339("Invalid Start Time")},
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "End of the window to backfill (RFC3339)",
+				Required:            true,
+				Validators:          []validator.String{validRFC3339("Invalid End Time")},
+			},
+			"overlap_policy": schema.StringAttribute{
+				MarkdownDescription: "Policy for backfilled workflows overlapping each other or running workflows. Defaults to the overlap policy of the schedule. " +
+					"Accepted values: Skip, BufferOne, BufferAll, CancelOther, TerminateOther, AllowAll",
+				Optional:   true,
+				Validators: scheduleActionOverlapPolicyValidators(),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the backfill window ends after it starts.
+func (a *ScheduleBackfillAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data ScheduleBackfillActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOK := configTime(data.StartTime)
+	end, endOK := configTime(data.EndTime)
+	if startOK && endOK && !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid End Time",
+			fmt.Sprintf("end_time %s must be after start_time %s.", end.Format(time.RFC3339), start.Format(time.RFC3339)),
+		)
+	}
+}
+
+// Invoke backfills the schedule.
+func (a *ScheduleBackfillAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ScheduleBackfillActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Times unknown when the configuration was validated are only checked now.
+	start, ok := configTime(data.StartTime)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid Start Time",
+			fmt.Sprintf("start_time must be an RFC3339 time, got %s.", data.StartTime),
+		)
+	}
+	end, ok := configTime(data.EndTime)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid End Time",
+			fmt.Sprintf("end_time must be an RFC3339 time, got %s.", data.EndTime),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid End Time",
+			fmt.Sprintf("end_time %s must be after start_time %s.", end.Format(time.RFC3339), start.Format(time.RFC3339)),
+		)
+		return
+	}
+
+	patch := &schedulev1.SchedulePatch{
+		BackfillRequest: []*schedulev1.BackfillRequest{{
+			StartTime:     timestamppb.New(start),
+			EndTime:       timestamppb.New(end),
+			OverlapPolicy: ScheduleOverlapPolicy[data.OverlapPolicy.ValueString()],
+		}},
+	}
+	resp.Diagnostics.Append(a.patchSchedule(ctx, "backfill", data.Namespace, data.ScheduleID.ValueString(), patch)...)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.temporal.io/api/enums/v1"
+)
+
+func TestScheduleBackfillAction(t *testing.T) {
+	conn := &patchScheduleConn{}
+	resp := invokeScheduleAction(t, NewScheduleBackfillAction().(action.ActionWithConfigure),
+		&TemporalProviderData{Conn: conn, Namespace: "default"},
+		map[string]tftypes.Value{
+			"namespace":   tftypes.NewValue(tftypes.String, "orders"),
+			"schedule_id": tftypes.NewValue(tftypes.String, "test-schedule"),
+			"start_time":  tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+			"end_time":    tftypes.NewValue(tftypes.String, "2025-01-02T00:00:00Z"),
+		})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+
+	if len(conn.requests) != 1 {
+		t.Fatalf("expected 1 PatchSchedule request, got %d", len(conn.requests))
+	}
+	req := conn.requests[0]
+	if req.GetNamespace() != "orders" {
+		t.Errorf("expected namespace orders, got %s", req.GetNamespace())
+	}
+	backfills := req.GetPatch().GetBackfillRequest()
+	if len(backfills) != 1 {
+		t.Fatalf("expected 1 backfill request, got %d", len(backfills))
+	}
+	if got := backfills[0].GetStartTime().AsTime(); !got.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start time %s", got)
+	}
+	if got := backfills[0].GetEndTime().AsTime(); !got.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected end time %s", got)
+	}
+	if got := backfills[0].GetOverlapPolicy(); got != enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		t.Errorf("expected the schedule overlap policy, got %s", got)
+	}
+}
+
+func TestScheduleBackfillAction_InvalidTimes(t *testing.T) {
+	cases := []struct {
+		name        string
+		start       tftypes.Value
+		end         tftypes.Value
+		wantSummary string
+	}{
+		{
+			name:        "null start time",
+			start:       tftypes.NewValue(tftypes.String, nil),
+			end:         tftypes.NewValue(tftypes.String, "2025-01-02T00:00:00Z"),
+			wantSummary: "Invalid Start Time",
+		},
+		{
+			name:        "invalid end time",
+			start:       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+			end:         tftypes.NewValue(tftypes.String, "tomorrow"),
+			wantSummary: "Invalid End Time",
+		},
+		{
+			name:        "end before start",
+			start:       tftypes.NewValue(tftypes.String, "2025-01-02T00:00:00Z"),
+			end:         tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+			wantSummary: "Invalid End Time",
+		},
+	}
+
+	for _, c := range cases {
+		conn := &patchScheduleConn{}
+		resp := invokeScheduleAction(t, NewScheduleBackfillAction().(action.ActionWithConfigure),
+			&TemporalProviderData{Conn: conn, Namespace: "default"},
+			map[string]tftypes.Value{
+				"schedule_id": tftypes.NewValue(tftypes.String, "test-schedule"),
+				"start_time":  c.start,
+				"end_time":    c.end,
+			})
+		if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != c.wantSummary {
+			t.Errorf("%s: expected %s error, got %v", c.name, c.wantSummary, resp.Diagnostics)
+		}
+		if len(conn.requests) != 0 {
+			t.Errorf("%s: expected no PatchSchedule request, got %d", c.name, len(conn.requests))
+		}
+	}
+}
+
+func TestScheduleBackfillAction_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	a := NewScheduleBackfillAction().(action.ActionWithValidateConfig)
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	var resp action.ValidateConfigResponse
+	a.ValidateConfig(ctx, action.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"namespace":      tftypes.NewValue(tftypes.String, nil),
+			"schedule_id":    tftypes.NewValue(tftypes.String, "test-schedule"),
+			"start_time":     tftypes.NewValue(tftypes.String, "2025-01-02T00:00:00Z"),
+			"end_time":       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+			"overlap_policy": tftypes.NewValue(tftypes.String, nil),
+		})},
+	}, &resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Invalid End Time" {
+		t.Fatalf("expected Invalid End Time error, got %v", resp.Diagnostics)
+	}
+}
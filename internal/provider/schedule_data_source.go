@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// Ensures that ScheduleDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &ScheduleDataSource{}
+	_ datasource.DataSourceWithConfigure = &ScheduleDataSource{}
+)
+
+// scheduleDataSourceAttributes are the temporal_schedule resource attributes exposed by
+// the data source, besides namespace and schedule_id.
+var scheduleDataSourceAttributes = []string{
+	"memo", "memo_json", "search_attributes", "spec", "action", "state", "policy_config", "info",
+}
+
+// NewScheduleDataSource returns a new instance of the ScheduleDataSource.
+func NewScheduleDataSource() datasource.DataSource {
+	return &ScheduleDataSource{}
+}
+
+// ScheduleDataSource implements the Terraform data source interface for Temporal schedules.
+type ScheduleDataSource struct {
+	client    grpc.ClientConnInterface
+	namespace string
+	codec     *payloadCodec
+}
+
+// ScheduleDataSourceModel defines the structure for the data source's configuration and read data.
+type ScheduleDataSourceModel struct {
+	Namespace        types.String         `tfsdk:"namespace"`
+	ScheduleID       types.String         `tfsdk:"schedule_id"`
+	Memo             types.Map            `tfsdk:"memo"`
+	MemoJSON         types.Map            `tfsdk:"memo_json"`
+	SearchAttributes types.Map            `tfsdk:"search_attributes"`
+	Spec             *ScheduleSpecModel   `tfsdk:"spec"`
+	Action           *ScheduleActionModel `tfsdk:"action"`
+	State            *ScheduleStateModel  `tfsdk:"state"`
+	Policy           *SchedulePolicyModel `tfsdk:"policy_config"`
+	Info             types.Object         `tfsdk:"info"`
+}
+
+// Metadata sets the metadata for the Temporal schedule data source, specifically the type name.
+func (d *ScheduleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+// Schema defines the schema for the Temporal schedule data source. Schedule attributes are
+// derived from the temporal_schedule resource so both stay in sync.
+func (d *ScheduleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchema resource.SchemaResponse
+	(&ScheduleResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	attributes := map[string]dschema.Attribute{
+		"namespace": dschema.StringAttribute{
+			MarkdownDescription: "Namespace where the schedule resides. If this is not provided, the provider namespace will be used",
+			Optional:            true,
+			Computed:            true,
+		},
+		"schedule_id": dschema.StringAttribute{
+			MarkdownDescription: "Unique identifier for the schedule",
+			Required:            true,
+		},
+	}
+	for _, name := range scheduleDataSourceAttributes {
+		attribute, err := dataSourceAttribute(resourceSchema.Schema.Attributes[name], dataSourceComputed)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Build Schedule Data Source Schema",
+				fmt.Sprintf("Could not convert the temporal_schedule attribute %s: %s. Please report this issue to the provider developers.", name, err),
+			)
+			continue
+		}
+		attributes[name] = attribute
+	}
+
+	resp.Schema = dschema.Schema{
+		MarkdownDescription: "Temporal Schedule data source, e.g. to reference a schedule managed in another configuration",
+		Attributes:          attributes,
+	}
+}
+
+// Configure sets up the schedule data source configuration.
+func (d *ScheduleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Schedule DataSource")
+
+	// Prevent panic if the provider has not been configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Conn
+	d.namespace = providerData.Namespace
+	d.codec = providerData.PayloadCodec
+
+	tflog.Info(ctx, "Configured Temporal Schedule client", map[string]any{"success": true})
+}
+
+// Read fetches a Temporal schedule and sets it in the Terraform state.
+func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Schedule")
+
+	var scheduleID string
+	var namespace types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule_id"), &scheduleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the user has not provided a namespace for the data source, use the provider namespace
+	if namespace.IsNull() {
+		namespace = types.StringValue(d.namespace)
+	}
+
+	client := workflowservice.NewWorkflowServiceClient(d.client)
+	describeResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace.ValueString(),
+		ScheduleId: scheduleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Schedule",
+			fmt.Sprintf("Could not read schedule %s in namespace %s: %s", scheduleID, namespace.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data, diags := scheduleToScheduleDataSourceModel(ctx, d.codec.withNamespace(namespace.ValueString()), namespace.ValueString(), scheduleID, describeResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// scheduleToScheduleDataSourceModel converts a DescribeSchedule response to the data source model.
+// Memo entries holding JSON strings are exposed in memo, all others in memo_json.
+func scheduleToScheduleDataSourceModel(ctx context.Context, codec *payloadCodec, namespace, scheduleID string, describeResp *workflowservice.DescribeScheduleResponse) (*ScheduleDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := &ScheduleDataSourceModel{
+		Namespace:  types.StringValue(namespace),
+		ScheduleID: types.StringValue(scheduleID),
+	}
+
+	memo, memoJSON, memoDiags := convertScheduleMemo(ctx, codec, describeResp.GetMemo(), types.MapNull(jsonType{}))
+	diags.Append(memoDiags...)
+	if len(memo.Elements()) == 0 {
+		memo = types.MapNull(types.StringType)
+	}
+	data.Memo, data.MemoJSON = memo, memoJSON
+
+	var fieldDiags diag.Diagnostics
+	data.SearchAttributes, fieldDiags = convertSearchAttributes(scheduleSearchAttributes(describeResp.GetSearchAttributes(), types.MapNull(types.StringType)), types.MapNull(types.StringType))
+	diags.Append(fieldDiags...)
+
+	data.Info, fieldDiags = convertScheduleInfo(ctx, describeResp.GetInfo(), describeResp.GetSchedule().GetState())
+	diags.Append(fieldDiags...)
+
+	schedule := describeResp.GetSchedule()
+	data.Spec = convertScheduleSpec(schedule.GetSpec(), nil)
+	if schedule.GetAction() != nil {
+		data.Action, fieldDiags = convertScheduleAction(ctx, codec, schedule.GetAction(), nil)
+		diags.Append(fieldDiags...)
+	}
+	if schedule.GetState() != nil {
+		data.State = convertScheduleState(schedule.GetState())
+	}
+	if schedule.GetPolicies() != nil {
+		data.Policy = convertSchedulePolicy(schedule.GetPolicies(), nil)
+	}
+
+	return data, diags
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	workflowservice "go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestScheduleDataSourceSchema verifies that every schedule attribute exposed by the data
+// source converts from the resource schema and that the result is a valid schema.
+func TestScheduleDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	var resp datasource.SchemaResponse
+	NewScheduleDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+	for _, name := range append([]string{"namespace", "schedule_id"}, scheduleDataSourceAttributes...) {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("missing attribute %q", name)
+		}
+	}
+	if _, ok := resp.Schema.Attributes["memo_all"]; ok {
+		t.Error("memo_all must not be exposed by the data source")
+	}
+}
+
+// TestScheduleDataSourceModel_NilSchedule verifies that scheduleToScheduleDataSourceModel
+// does not panic when the described schedule has no spec, action, state or policies.
+func TestScheduleDataSourceModel_NilSchedule(t *testing.T) {
+	ctx := context.Background()
+	describeResp := &workflowservice.DescribeScheduleResponse{
+		Schedule: &schedulev1.Schedule{},
+		Info:     &schedulev1.ScheduleInfo{CreateTime: timestamppb.Now()},
+	}
+
+	model, diags := scheduleToScheduleDataSourceModel(ctx, nil, "default", "test-schedule", describeResp)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if model.ScheduleID.ValueString() != "test-schedule" || model.Namespace.ValueString() != "default" {
+		t.Errorf("unexpected id %s/%s", model.Namespace.ValueString(), model.ScheduleID.ValueString())
+	}
+	if !model.Memo.IsNull() || !model.MemoJSON.IsNull() {
+		t.Error("expected null memo and memo_json for a schedule without memo")
+	}
+	if model.Action != nil || model.State != nil || model.Policy != nil {
+		t.Error("expected nil action, state and policy_config")
+	}
+	if model.Info.IsNull() {
+		t.Error("expected info to be set")
+	}
+}
+
+// TestConvertScheduleListEntry verifies that list entries without info convert to empty values.
+func TestConvertScheduleListEntry(t *testing.T) {
+	summary := convertScheduleListEntry(&schedulev1.ScheduleListEntry{ScheduleId: "test-schedule"})
+	if summary.ScheduleID.ValueString() != "test-schedule" {
+		t.Errorf("expected schedule_id test-schedule, got %s", summary.ScheduleID.ValueString())
+	}
+	if summary.WorkflowType.ValueString() != "" || summary.Paused.ValueBool() {
+		t.Error("expected empty workflow_type and paused false")
+	}
+	if summary.NextActionTimes == nil {
+		t.Error("next_action_times must be an empty list, not null")
+	}
+}
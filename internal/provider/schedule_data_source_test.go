@@ -0,0 +1,69 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleDataSource(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id = "%s"
+
+  spec = {
+    intervals = [{
+      every = "1h"
+    }]
+  }
+
+  state = {
+    paused = true
+    notes  = "created by terraform"
+  }
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+
+data "temporal_schedule" "test" {
+  schedule_id = temporal_schedule.test.schedule_id
+}
+
+data "temporal_schedules" "paused" {
+  query = "TemporalSchedulePaused = true"
+
+  depends_on = [temporal_schedule.test]
+}
+`, scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "spec.intervals.0.every", "1h"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "state.paused", "true"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "state.notes", "created by terraform"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "action.workflow.workflow_type", "TestWorkflow"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.test", "action.workflow.task_queue", "test-queue"),
+					resource.TestCheckResourceAttrSet("data.temporal_schedule.test", "info.create_time"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.paused", "namespace", "default"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.temporal_schedules.paused", "schedules.*", map[string]string{
+						"schedule_id":   scheduleName,
+						"workflow_type": "TestWorkflow",
+						"paused":        "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleInfoModel describes the schedule as last seen by the Temporal server.
+type ScheduleInfoModel struct {
+	Paused              types.Bool                  `tfsdk:"paused"`
+	Notes               types.String                `tfsdk:"notes"`
+	LimitedActions      types.Bool                  `tfsdk:"limited_actions"`
+	RemainingActions    types.Int64                 `tfsdk:"remaining_actions"`
+	ActionCount         types.Int64                 `tfsdk:"action_count"`
+	MissedCatchupWindow types.Int64                 `tfsdk:"missed_catchup_window"`
+	RunningWorkflows    []ScheduleWorkflowModel     `tfsdk:"running_workflows"`
+	RecentActions       []ScheduleActionResultModel `tfsdk:"recent_actions"`
+	NextActionTimes     []types.String              `tfsdk:"next_action_times"`
+	CreateTime          types.String                `tfsdk:"create_time"`
+	UpdateTime          types.String                `tfsdk:"update_time"`
+}
+
+// ScheduleWorkflowModel identifies a workflow started by the schedule.
+type ScheduleWorkflowModel struct {
+	WorkflowID types.String `tfsdk:"workflow_id"`
+	RunID      types.String `tfsdk:"run_id"`
+}
+
+// ScheduleActionResultModel describes an action taken by the schedule.
+type ScheduleActionResultModel struct {
+	ScheduleTime types.String `tfsdk:"schedule_time"`
+	ActualTime   types.String `tfsdk:"actual_time"`
+	WorkflowID   types.String `tfsdk:"workflow_id"`
+	RunID        types.String `tfsdk:"run_id"`
+}
+
+var scheduleWorkflowAttrTypes = map[string]attr.Type{
+	"workflow_id": types.StringType,
+	"run_id":      types.StringType,
+}
+
+var scheduleActionResultAttrTypes = map[string]attr.Type{
+	"schedule_time": types.StringType,
+	"actual_time":   types.StringType,
+	"workflow_id":   types.StringType,
+	"run_id":        types.StringType,
+}
+
+var scheduleInfoAttrTypes = map[string]attr.Type{
+	"paused":                types.BoolType,
+	"notes":                 types.StringType,
+	"limited_actions":       types.BoolType,
+	"remaining_actions":     types.Int64Type,
+	"action_count":          types.Int64Type,
+	"missed_catchup_window": types.Int64Type,
+	"running_workflows":     types.ListType{ElemType: types.ObjectType{AttrTypes: scheduleWorkflowAttrTypes}},
+	"recent_actions":        types.ListType{ElemType: types.ObjectType{AttrTypes: scheduleActionResultAttrTypes}},
+	"next_action_times":     types.ListType{ElemType: types.StringType},
+	"create_time":           types.StringType,
+	"update_time":           types.StringType,
+}
+
+// scheduleInfoAttribute returns the computed schedule info attribute.
+func scheduleInfoAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Schedule info reported by Temporal, refreshed on every read",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule is currently paused, e.g. by an operator in the Temporal UI",
+				Computed:            true,
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Current notes of the schedule, e.g. the reason it was paused",
+				Computed:            true,
+			},
+			"limited_actions": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule is currently limited to `remaining_actions` actions",
+				Computed:            true,
+			},
+			"remaining_actions": schema.Int64Attribute{
+				MarkdownDescription: "Number of actions the schedule may still take when `limited_actions` is set",
+				Computed:            true,
+			},
+			"action_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of actions taken so far",
+				Computed:            true,
+			},
+			"missed_catchup_window": schema.Int64Attribute{
+				MarkdownDescription: "Number of times an action was skipped because it was outside the catch-up window",
+				Computed:            true,
+			},
+			"running_workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "Workflows started by the schedule that are still running",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workflow_id": schema.StringAttribute{
+							MarkdownDescription: "Workflow ID",
+							Computed:            true,
+						},
+						"run_id": schema.StringAttribute{
+							MarkdownDescription: "Run ID",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"recent_actions": schema.ListNestedAttribute{
+				MarkdownDescription: "Most recent actions taken, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_time": schema.StringAttribute{
+							MarkdownDescription: "Time the action was scheduled for, including jitter (RFC3339)",
+							Computed:            true,
+						},
+						"actual_time": schema.StringAttribute{
+							MarkdownDescription: "Time the action was taken (RFC3339)",
+							Computed:            true,
+						},
+						"workflow_id": schema.StringAttribute{
+							MarkdownDescription: "Workflow ID of the started workflow",
+							Computed:            true,
+						},
+						"run_id": schema.StringAttribute{
+							MarkdownDescription: "Run ID of the started workflow",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"next_action_times": schema.ListAttribute{
+				MarkdownDescription: "Next times the schedule will take an action (RFC3339)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"create_time": schema.StringAttribute{
+				MarkdownDescription: "Time the schedule was created (RFC3339)",
+				Computed:            true,
+			},
+			"update_time": schema.StringAttribute{
+				MarkdownDescription: "Time the schedule was last updated (RFC3339)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// convertScheduleInfo converts Temporal ScheduleInfo and the current schedule state to a
+// Terraform object.
+func convertScheduleInfo(ctx context.Context, info *schedulev1.ScheduleInfo, state *schedulev1.ScheduleState) (types.Object, diag.Diagnostics) {
+	if info == nil {
+		return types.ObjectNull(scheduleInfoAttrTypes), nil
+	}
+
+	model := ScheduleInfoModel{
+		Paused:              types.BoolValue(state.GetPaused()),
+		Notes:               types.StringValue(state.GetNotes()),
+		LimitedActions:      types.BoolValue(state.GetLimitedActions()),
+		RemainingActions:    types.Int64Value(state.GetRemainingActions()),
+		ActionCount:         types.Int64Value(info.GetActionCount()),
+		MissedCatchupWindow: types.Int64Value(info.GetMissedCatchupWindow()),
+		RunningWorkflows:    []ScheduleWorkflowModel{},
+		RecentActions:       []ScheduleActionResultModel{},
+		NextActionTimes:     []types.String{},
+		CreateTime:          formatTimestamp(info.GetCreateTime()),
+		UpdateTime:          formatTimestamp(info.GetUpdateTime()),
+	}
+	for _, wf := range info.GetRunningWorkflows() {
+		model.RunningWorkflows = append(model.RunningWorkflows, ScheduleWorkflowModel{
+			WorkflowID: types.StringValue(wf.GetWorkflowId()),
+			RunID:      types.StringValue(wf.GetRunId()),
+		})
+	}
+	for _, action := range info.GetRecentActions() {
+		model.RecentActions = append(model.RecentActions, ScheduleActionResultModel{
+			ScheduleTime: formatTimestamp(action.GetScheduleTime()),
+			ActualTime:   formatTimestamp(action.GetActualTime()),
+			WorkflowID:   types.StringValue(action.GetStartWorkflowResult().GetWorkflowId()),
+			RunID:        types.StringValue(action.GetStartWorkflowResult().GetRunId()),
+		})
+	}
+	for _, t := range info.GetFutureActionTimes() {
+		model.NextActionTimes = append(model.NextActionTimes, formatTimestamp(t))
+	}
+
+	return types.ObjectValueFrom(ctx, scheduleInfoAttrTypes, model)
+}
+
+// formatTimestamp formats a timestamp as RFC3339, or returns null when it is unset.
+func formatTimestamp(t *timestamppb.Timestamp) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.AsTime().Format(time.RFC3339Nano))
+}
+
+// readScheduleInfo describes the schedule and returns its info. Failures are reported as
+// warnings with a null info, since the schedule itself was already applied.
+func (r *ScheduleResource) readScheduleInfo(ctx context.Context, namespace, scheduleID string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	client := workflowservice.NewWorkflowServiceClient(r.client)
+
+	describeResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
+	if err != nil {
+		diags.AddWarning(
+			"Error Reading Schedule Info",
+			fmt.Sprintf("Could not read the info of schedule %s, it will be refreshed on the next read: %s", scheduleID, err.Error()),
+		)
+		return types.ObjectNull(scheduleInfoAttrTypes), diags
+	}
+
+	info, infoDiags := convertScheduleInfo(ctx, describeResp.GetInfo(), describeResp.GetSchedule().GetState())
+	diags.Append(infoDiags...)
+	return info, diags
+}
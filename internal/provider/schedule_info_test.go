@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	commonv1 "go.temporal.io/api/common/v1"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestConvertScheduleInfo verifies that the schedule info reported by Temporal is exposed
+// with RFC3339 times and that unset values stay null or empty.
+func TestConvertScheduleInfo(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	got, diags := convertScheduleInfo(ctx, &schedulev1.ScheduleInfo{
+		ActionCount:         3,
+		MissedCatchupWindow: 1,
+		RunningWorkflows:    []*commonv1.WorkflowExecution{{WorkflowId: "wf-3", RunId: "run-3"}},
+		RecentActions: []*schedulev1.ScheduleActionResult{{
+			ScheduleTime:        timestamppb.New(created.Add(time.Hour)),
+			ActualTime:          timestamppb.New(created.Add(time.Hour + time.Second)),
+			StartWorkflowResult: &commonv1.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"},
+		}},
+		FutureActionTimes: []*timestamppb.Timestamp{timestamppb.New(created.Add(2 * time.Hour))},
+		CreateTime:        timestamppb.New(created),
+	}, &schedulev1.ScheduleState{Paused: true, Notes: "paused during incident"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	var model ScheduleInfoModel
+	if diags := got.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	want := ScheduleInfoModel{
+		Paused:              types.BoolValue(true),
+		Notes:               types.StringValue("paused during incident"),
+		LimitedActions:      types.BoolValue(false),
+		RemainingActions:    types.Int64Value(0),
+		ActionCount:         types.Int64Value(3),
+		MissedCatchupWindow: types.Int64Value(1),
+		RunningWorkflows: []ScheduleWorkflowModel{{
+			WorkflowID: types.StringValue("wf-3"),
+			RunID:      types.StringValue("run-3"),
+		}},
+		RecentActions: []ScheduleActionResultModel{{
+			ScheduleTime: types.StringValue("2025-01-01T10:00:00Z"),
+			ActualTime:   types.StringValue("2025-01-01T10:00:01Z"),
+			WorkflowID:   types.StringValue("wf-3"),
+			RunID:        types.StringValue("run-3"),
+		}},
+		NextActionTimes: []types.String{types.StringValue("2025-01-01T11:00:00Z")},
+		CreateTime:      types.StringValue("2025-01-01T09:00:00Z"),
+		UpdateTime:      types.StringNull(),
+	}
+	if !reflect.DeepEqual(model, want) {
+		t.Errorf("got %+v, want %+v", model, want)
+	}
+
+	if got, _ := convertScheduleInfo(ctx, nil, nil); !got.IsNull() {
+		t.Errorf("expected null info, got %v", got)
+	}
+}
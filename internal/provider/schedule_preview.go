@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"time"
+
+	schedulev1 "go.temporal.io/api/schedule/v1"
+)
+
+// maxCalendarYear is the last year Temporal computes schedule times for.
+const maxCalendarYear = 2100
+
+// maxExcludedTimes bounds the excluded candidates skipped to find one action time, so a
+// frequent interval with broad exclusions does not scan every time up to maxCalendarYear.
+const maxExcludedTimes = 100000
+
+// compiledSpec approximates the action times of a schedule spec: the earliest calendar or
+// interval time after a given time, skipping excluded times and staying within start_time
+// and end_time, with a deterministic jitter. It follows the Temporal scheduler's rules but
+// is a separate implementation, so the server may compute different times.
+type compiledSpec struct {
+	calendars []compiledCalendar
+	excludes  []compiledCalendar
+	intervals []*schedulev1.IntervalSpec
+	startTime *time.Time
+	endTime   *time.Time
+	jitter    time.Duration
+}
+
+// compiledCalendar holds the values matched by each field of a calendar in its time zone.
+// A nil year matches every year.
+type compiledCalendar struct {
+	tz                                                       *time.Location
+	second, minute, hour, dayOfMonth, month, dayOfWeek, year []bool
+}
+
+// scheduleTime is an action time of a schedule: the nominal time from the spec and the
+// time the action is taken after jitter.
+type scheduleTime struct {
+	nominal time.Time
+	next    time.Time
+}
+
+// compileScheduleSpec compiles calendars, structured calendars, cron strings, intervals and
+// excluded calendars of spec.
+func compileScheduleSpec(spec *schedulev1.ScheduleSpec) (*compiledSpec, error) {
+	tz, err := time.LoadLocation(spec.GetTimezoneName())
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %s", spec.GetTimezoneName())
+	}
+
+	compiled := &compiledSpec{
+		intervals: spec.GetInterval(),
+		jitter:    spec.GetJitter().AsDuration(),
+	}
+	for _, calendar := range spec.GetStructuredCalendar() {
+		compiled.calendars = append(compiled.calendars, compileCalendar(calendar, tz))
+	}
+	for _, calendar := range spec.GetCalendar() {
+		structured, err := parseCalendarSpec(calendar)
+		if err != nil {
+			return nil, err
+		}
+		compiled.calendars = append(compiled.calendars, compileCalendar(structured, tz))
+	}
+	for _, cronString := range spec.GetCronString() {
+		cron, err := parseCronString(cronString)
+		if err != nil {
+			return nil, err
+		}
+		if cron.calendar != nil {
+			compiled.calendars = append(compiled.calendars, compileCalendar(cron.calendar, tz))
+		} else {
+			compiled.intervals = append(slices.Clip(compiled.intervals), cron.interval)
+		}
+	}
+	for _, calendar := range spec.GetExcludeCalendar() {
+		structured, err := parseCalendarSpec(calendar)
+		if err != nil {
+			return nil, err
+		}
+		compiled.excludes = append(compiled.excludes, compileCalendar(structured, tz))
+	}
+	for _, calendar := range spec.GetExcludeStructuredCalendar() {
+		compiled.excludes = append(compiled.excludes, compileCalendar(calendar, tz))
+	}
+	if spec.GetStartTime() != nil {
+		start := spec.GetStartTime().AsTime()
+		compiled.startTime = &start
+	}
+	if spec.GetEndTime() != nil {
+		end := spec.GetEndTime().AsTime()
+		compiled.endTime = &end
+	}
+
+	return compiled, nil
+}
+
+// parseCalendarSpec parses the string fields of a calendar spec into a structured calendar.
+func parseCalendarSpec(calendar *schedulev1.CalendarSpec) (*schedulev1.StructuredCalendarSpec, error) {
+	structured := &schedulev1.StructuredCalendarSpec{Comment: calendar.GetComment()}
+	for _, f := range []struct {
+		field calendarField
+		value string
+		out   *[]*schedulev1.Range
+	}{
+		{calendarSecond, calendar.GetSecond(), &structured.Second},
+		{calendarMinute, calendar.GetMinute(), &structured.Minute},
+		{calendarHour, calendar.GetHour(), &structured.Hour},
+		{calendarDayOfMonth, calendar.GetDayOfMonth(), &structured.DayOfMonth},
+		{calendarMonth, calendar.GetMonth(), &structured.Month},
+		{calendarYear, calendar.GetYear(), &structured.Year},
+		{calendarDayOfWeek, calendar.GetDayOfWeek(), &structured.DayOfWeek},
+	} {
+		ranges, err := f.field.parse(f.value)
+		if err != nil {
+			return nil, err
+		}
+		*f.out = ranges
+	}
+
+	return structured, nil
+}
+
+// compileCalendar compiles a structured calendar. Empty fields take their default values.
+func compileCalendar(calendar *schedulev1.StructuredCalendarSpec, tz *time.Location) compiledCalendar {
+	matches := func(field calendarField, ranges []*schedulev1.Range) []bool {
+		if len(ranges) == 0 {
+			ranges, _ = field.parse(field.def)
+		}
+		return field.matches(ranges)
+	}
+
+	return compiledCalendar{
+		tz:         tz,
+		second:     matches(calendarSecond, calendar.GetSecond()),
+		minute:     matches(calendarMinute, calendar.GetMinute()),
+		hour:       matches(calendarHour, calendar.GetHour()),
+		dayOfMonth: matches(calendarDayOfMonth, calendar.GetDayOfMonth()),
+		month:      matches(calendarMonth, calendar.GetMonth()),
+		dayOfWeek:  matches(calendarDayOfWeek, calendar.GetDayOfWeek()),
+		year:       matches(calendarYear, calendar.GetYear()),
+	}
+}
+
+// matches reports whether t is a time of the calendar.
+func (c compiledCalendar) matches(t time.Time) bool {
+	t = t.In(c.tz)
+	return t.Nanosecond() == 0 && (c.year == nil || t.Year() <= maxCalendarYear && c.year[t.Year()]) &&
+		c.month[t.Month()] && c.dayOfMonth[t.Day()] && c.dayOfWeek[t.Weekday()] &&
+		c.hour[t.Hour()] && c.minute[t.Minute()] && c.second[t.Second()]
+}
+
+// next returns the first time of the calendar after after, or the zero time if there is
+// none before the end of maxCalendarYear.
+func (c compiledCalendar) next(after time.Time) time.Time {
+	t := after.In(c.tz)
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	// Calendar times are whole seconds, so the first candidate is the next second.
+	s++
+
+	for y <= maxCalendarYear {
+		switch {
+		case s > 59:
+			mi, s = mi+1, 0
+		case mi > 59:
+			h, mi = h+1, 0
+		case h > 23:
+			d, h = d+1, 0
+		case mo > time.December:
+			y, mo, d = y+1, time.January, 1
+		case d > daysIn(y, mo):
+			mo, d = mo+1, 1
+		case c.year != nil && !c.year[y]:
+			y, mo, d, h, mi, s = y+1, time.January, 1, 0, 0, 0
+		case !c.month[mo]:
+			mo, d, h, mi, s = mo+1, 1, 0, 0, 0
+		case !c.dayOfMonth[d] || !c.dayOfWeek[time.Date(y, mo, d, 0, 0, 0, 0, time.UTC).Weekday()]:
+			d, h, mi, s = d+1, 0, 0, 0
+		case !c.hour[h]:
+			h, mi, s = h+1, 0, 0
+		case !c.minute[mi]:
+			mi, s = mi+1, 0
+		case !c.second[s]:
+			s++
+		default:
+			// Wall clock times skipped by a DST change resolve to a later time, and repeated
+			// ones to their first occurrence.
+			if next := time.Date(y, mo, d, h, mi, s, 0, c.tz); next.After(after) {
+				return next
+			}
+			s++
+		}
+	}
+
+	return time.Time{}
+}
+
+// daysIn returns the number of days in month mo of year y.
+func daysIn(y int, mo time.Month) int {
+	return time.Date(y, mo+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nextTimes returns up to count action times after after. jitterSeed identifies the
+// schedule to derive a deterministic jitter.
+func (cs *compiledSpec) nextTimes(jitterSeed string, after time.Time, count int) ([]scheduleTime, error) {
+	var times []scheduleTime
+	for len(times) < count {
+		t, ok, err := cs.nextTime(jitterSeed, after)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		times = append(times, t)
+		after = t.nominal
+	}
+
+	return times, nil
+}
+
+// nextTime returns the first action time after after. An error is returned when more than
+// maxExcludedTimes candidates in a row are excluded.
+func (cs *compiledSpec) nextTime(jitterSeed string, after time.Time) (scheduleTime, bool, error) {
+	// Before the schedule's start time, jump up to it.
+	if cs.startTime != nil && after.Before(*cs.startTime) {
+		after = cs.startTime.Add(-time.Second)
+	}
+
+	from := after
+	var nominal time.Time
+	for skipped := 0; nominal.IsZero() || cs.excluded(nominal); skipped++ {
+		if skipped > maxExcludedTimes {
+			return scheduleTime{}, false, fmt.Errorf("more than %d consecutive times are excluded after %s", maxExcludedTimes, from.Format(time.RFC3339))
+		}
+		nominal = cs.rawNextTime(after)
+		after = nominal
+		if nominal.IsZero() || cs.endTime != nil && nominal.After(*cs.endTime) || nominal.Year() > maxCalendarYear {
+			return scheduleTime{}, false, nil
+		}
+	}
+
+	// Jitter never pushes an action past the following nominal time.
+	maxJitter := cs.jitter
+	if following := cs.rawNextTime(nominal); !following.IsZero() {
+		maxJitter = min(maxJitter, following.Sub(nominal))
+	}
+
+	return scheduleTime{nominal: nominal, next: addJitter(jitterSeed, nominal, maxJitter)}, true, nil
+}
+
+// rawNextTime returns the earliest calendar or interval time after after, ignoring
+// excluded times, start_time and end_time.
+func (cs *compiledSpec) rawNextTime(after time.Time) time.Time {
+	var minTimestamp int64 = math.MaxInt64
+	for _, calendar := range cs.calendars {
+		if next := calendar.next(after); !next.IsZero() {
+			minTimestamp = min(minTimestamp, next.UnixNano())
+		}
+	}
+	for _, interval := range cs.intervals {
+		minTimestamp = min(minTimestamp, nextIntervalTime(interval, after.UnixNano()))
+	}
+	if minTimestamp == math.MaxInt64 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, minTimestamp).UTC()
+}
+
+// nextIntervalTime returns the first time after ts, in Unix nanoseconds, that is a multiple
+// of the interval since the epoch plus its phase.
+func nextIntervalTime(interval *schedulev1.IntervalSpec, ts int64) int64 {
+	every := max(int64(interval.GetInterval().AsDuration()), 1)
+	phase := max(int64(interval.GetPhase().AsDuration()), 0)
+
+	return ((ts-phase)/every+1)*every + phase
+}
+
+func (cs *compiledSpec) excluded(nominal time.Time) bool {
+	for _, calendar := range cs.excludes {
+		if calendar.matches(nominal) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addJitter delays nominal by a pseudo-random amount below maxJitter derived from a hash
+// of the seed and the nominal time, with millisecond resolution. The amount is stable for
+// a schedule but may differ from the jitter the server applies.
+func addJitter(seed string, nominal time.Time, maxJitter time.Duration) time.Time {
+	if maxJitter <= 0 || seed == "" {
+		return nominal
+	}
+	bin, err := nominal.MarshalBinary()
+	if err != nil {
+		return nominal
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	_, _ = h.Write(bin)
+
+	maxJitterMs := min(maxJitter.Milliseconds(), int64(math.MaxUint32))
+	ms := int64(h.Sum64()>>32) * maxJitterMs >> 32
+	return nominal.Add(time.Duration(ms) * time.Millisecond)
+}
+
+// scheduleJitterSeed returns the jitter seed of a schedule, built from the namespace ID
+// and the schedule ID like the Temporal scheduler does.
+func scheduleJitterSeed(namespaceID, scheduleID string) string {
+	return fmt.Sprintf("%s-%s", namespaceID, scheduleID)
+}
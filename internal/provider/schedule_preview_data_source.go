@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// defaultPreviewLimit is the number of action times returned when limit is not set.
+const defaultPreviewLimit = 10
+
+// Ensures that SchedulePreviewDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &SchedulePreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &SchedulePreviewDataSource{}
+)
+
+// NewSchedulePreviewDataSource returns a new instance of the SchedulePreviewDataSource.
+func NewSchedulePreviewDataSource() datasource.DataSource {
+	return &SchedulePreviewDataSource{}
+}
+
+// SchedulePreviewDataSource computes the next action times of a schedule spec without
+// creating the schedule.
+type SchedulePreviewDataSource struct {
+	client    grpc.ClientConnInterface
+	namespace string
+}
+
+// SchedulePreviewDataSourceModel defines the structure for the data source's configuration and read data.
+type SchedulePreviewDataSourceModel struct {
+	Spec            *ScheduleSpecModel `tfsdk:"spec"`
+	Limit           types.Int64        `tfsdk:"limit"`
+	StartTime       types.String       `tfsdk:"start_time"`
+	Namespace       types.String       `tfsdk:"namespace"`
+	ScheduleID      types.String       `tfsdk:"schedule_id"`
+	NominalTimes    []types.String     `tfsdk:"nominal_times"`
+	NextActionTimes []types.String     `tfsdk:"next_action_times"`
+}
+
+// Metadata sets the metadata for the Temporal schedule preview data source, specifically the type name.
+func (d *SchedulePreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_preview"
+}
+
+// Schema defines the schema for the Temporal schedule preview data source. The spec
+// attribute is derived from the temporal_schedule resource so both accept the same values.
+func (d *SchedulePreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchema resource.SchemaResponse
+	(&ScheduleResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	spec, err := dataSourceAttribute(resourceSchema.Schema.Attributes["spec"], dataSourceConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Build Schedule Preview Data Source Schema",
+			fmt.Sprintf("Could not convert the temporal_schedule attribute spec: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	resp.Schema = dschema.Schema{
+		MarkdownDescription: "Computes the next action times of a schedule spec without creating the schedule, " +
+			"e.g. to review a calendar change before applying it. Times are an approximation computed by the " +
+			"provider following the calendar, interval, exclusion and jitter rules of the Temporal scheduler; " +
+			"the server may take actions at different times, so use the schedule's `info.next_action_times` " +
+			"once it exists.",
+
+		Attributes: map[string]dschema.Attribute{
+			"spec": spec,
+			"limit": dschema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of action times to compute, 1-1000. Defaults to %d", defaultPreviewLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"start_time": dschema.StringAttribute{
+				MarkdownDescription: "Compute action times after this time (RFC3339). Defaults to the current time",
+				Optional:            true,
+				Validators: []validator.String{
+					validRFC3339("Invalid Start Time"),
+				},
+			},
+			"namespace": dschema.StringAttribute{
+				MarkdownDescription: "Namespace of the schedule, used with `schedule_id` to compute jitter. If this is not provided, the provider namespace will be used",
+				Optional:            true,
+				Computed:            true,
+			},
+			"schedule_id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the schedule. Jitter depends on the schedule, so `next_action_times` only include jitter when this is set",
+				Optional:            true,
+			},
+			"nominal_times": dschema.ListAttribute{
+				MarkdownDescription: "Next times matched by the spec, before jitter (RFC3339)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"next_action_times": dschema.ListAttribute{
+				MarkdownDescription: "Approximate next times the schedule would take an action, including jitter when `schedule_id` is set (RFC3339)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure sets up the schedule preview data source configuration.
+func (d *SchedulePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Schedule Preview DataSource")
+
+	// Prevent panic if the provider has not been configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Conn
+	d.namespace = providerData.Namespace
+
+	tflog.Info(ctx, "Configured Temporal Schedule Preview client", map[string]any{"success": true})
+}
+
+// Read computes the next action times of the configured spec.
+func (d *SchedulePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Schedule Preview")
+
+	var data SchedulePreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the user has not provided a namespace for the data source, use the provider namespace
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue(d.namespace)
+	}
+
+	spec, diags := convertToScheduleSpec(data.Spec)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	compiled, err := compileScheduleSpec(spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Schedule Spec", fmt.Sprintf("Unable to compute action times: %s", err))
+		return
+	}
+
+	after := time.Now()
+	if !data.StartTime.IsNull() {
+		after, err = time.Parse(time.RFC3339, data.StartTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Start Time",
+				fmt.Sprintf("Unable to parse start time: %s. Error: %s", data.StartTime.ValueString(), err),
+			)
+			return
+		}
+	}
+	limit := defaultPreviewLimit
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	// Jitter is seeded with the namespace ID and the schedule ID.
+	jitterSeed := ""
+	if !data.ScheduleID.IsNull() && spec.GetJitter().AsDuration() > 0 {
+		client := workflowservice.NewWorkflowServiceClient(d.client)
+		ns, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+			Namespace: data.Namespace.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Namespace",
+				fmt.Sprintf("Could not read namespace %s to compute jitter: %s", data.Namespace.ValueString(), err.Error()),
+			)
+			return
+		}
+		jitterSeed = scheduleJitterSeed(ns.GetNamespaceInfo().GetId(), data.ScheduleID.ValueString())
+	}
+
+	times, err := compiled.nextTimes(jitterSeed, after, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Too Many Excluded Times",
+			fmt.Sprintf("Unable to compute action times: %s. Narrow the excluded calendars or use a less frequent interval.", err),
+		)
+		return
+	}
+
+	data.NominalTimes = []types.String{}
+	data.NextActionTimes = []types.String{}
+	for _, t := range times {
+		data.NominalTimes = append(data.NominalTimes, types.StringValue(t.nominal.Format(time.RFC3339)))
+		data.NextActionTimes = append(data.NextActionTimes, types.StringValue(t.next.Format(time.RFC3339Nano)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,42 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchedulePreviewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "temporal_schedule_preview" "test" {
+  start_time = "2025-01-03T00:00:00Z"
+  limit      = 3
+
+  spec = {
+    calendar_items = [{
+      hour        = "9"
+      minute      = "30"
+      day_of_week = "MON-FRI"
+    }]
+    exclude_calendar_items = [{
+      month        = "1"
+      day_of_month = "6"
+    }]
+    time_zone = "Europe/Berlin"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_schedule_preview.test", "nominal_times.#", "3"),
+					resource.TestCheckResourceAttr("data.temporal_schedule_preview.test", "nominal_times.0", "2025-01-03T08:30:00Z"),
+					resource.TestCheckResourceAttr("data.temporal_schedule_preview.test", "nominal_times.1", "2025-01-07T08:30:00Z"),
+					resource.TestCheckResourceAttr("data.temporal_schedule_preview.test", "next_action_times.2", "2025-01-08T08:30:00Z"),
+				),
+			},
+		},
+	})
+}
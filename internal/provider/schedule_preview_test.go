@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func mustNextTimes(t *testing.T, compiled *compiledSpec, jitterSeed string, after time.Time, count int) []scheduleTime {
+	t.Helper()
+	times, err := compiled.nextTimes(jitterSeed, after, count)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return times
+}
+
+func previewTimes(t *testing.T, spec *schedulev1.ScheduleSpec, after string, count int) []string {
+	t.Helper()
+	compiled, err := compileScheduleSpec(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var times []string
+	for _, st := range mustNextTimes(t, compiled, "", mustParseTime(t, after), count) {
+		times = append(times, st.nominal.Format(time.RFC3339))
+	}
+	return times
+}
+
+func assertTimes(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("time %d: expected %s, got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestCompiledSpec_Calendar(t *testing.T) {
+	spec := &schedulev1.ScheduleSpec{
+		Calendar:     []*schedulev1.CalendarSpec{{Hour: "9", Minute: "30", DayOfWeek: "MON-FRI"}},
+		TimezoneName: "Europe/Berlin",
+	}
+
+	// 2025-01-03 is a Friday, the weekend is skipped.
+	assertTimes(t, previewTimes(t, spec, "2025-01-03T08:30:00Z", 3),
+		"2025-01-06T08:30:00Z", "2025-01-07T08:30:00Z", "2025-01-08T08:30:00Z")
+	// A time exactly at an action is not included.
+	assertTimes(t, previewTimes(t, spec, "2025-01-06T08:30:00Z", 1), "2025-01-07T08:30:00Z")
+}
+
+func TestCompiledSpec_LastDayOfMonth(t *testing.T) {
+	spec := &schedulev1.ScheduleSpec{
+		StructuredCalendar: []*schedulev1.StructuredCalendarSpec{{
+			DayOfMonth: []*schedulev1.Range{{Start: 31}},
+		}},
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-01-31T00:00:00Z", 3),
+		"2025-03-31T00:00:00Z", "2025-05-31T00:00:00Z", "2025-07-31T00:00:00Z")
+}
+
+func TestCompiledSpec_IntervalExcludeAndEnd(t *testing.T) {
+	spec := &schedulev1.ScheduleSpec{
+		Interval: []*schedulev1.IntervalSpec{{
+			Interval: durationpb.New(6 * time.Hour),
+			Phase:    durationpb.New(time.Hour),
+		}},
+		ExcludeCalendar: []*schedulev1.CalendarSpec{{Hour: "13"}},
+		EndTime:         timestamppb.New(mustParseTime(t, "2025-01-02T08:00:00Z")),
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-01-01T02:00:00Z", 10),
+		"2025-01-01T07:00:00Z", "2025-01-01T19:00:00Z", "2025-01-02T01:00:00Z", "2025-01-02T07:00:00Z")
+}
+
+func TestCompiledSpec_StartTime(t *testing.T) {
+	spec := &schedulev1.ScheduleSpec{
+		Interval:  []*schedulev1.IntervalSpec{{Interval: durationpb.New(time.Hour)}},
+		StartTime: timestamppb.New(mustParseTime(t, "2025-06-01T00:00:00Z")),
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-01-01T00:00:00Z", 2), "2025-06-01T00:00:00Z", "2025-06-01T01:00:00Z")
+}
+
+func TestCompiledSpec_DaylightSaving(t *testing.T) {
+	// 02:30 does not exist in Berlin on 2025-03-30, when clocks move from 02:00 to 03:00.
+	spec := &schedulev1.ScheduleSpec{
+		Calendar:     []*schedulev1.CalendarSpec{{Hour: "2", Minute: "30"}},
+		TimezoneName: "Europe/Berlin",
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-03-29T00:00:00Z", 3),
+		"2025-03-29T01:30:00Z", "2025-03-30T01:30:00Z", "2025-03-31T00:30:00Z")
+}
+
+func TestCompiledSpec_CronAndYear(t *testing.T) {
+	spec, diags := convertToScheduleSpec(&ScheduleSpecModel{
+		CronItems: []types.String{types.StringValue("CRON_TZ=America/New_York 0 12 1 1 * 2026-2027")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-01-01T00:00:00Z", 5), "2026-01-01T17:00:00Z", "2027-01-01T17:00:00Z")
+}
+
+func TestCompiledSpec_Jitter(t *testing.T) {
+	compiled, err := compileScheduleSpec(&schedulev1.ScheduleSpec{
+		Interval: []*schedulev1.IntervalSpec{{Interval: durationpb.New(time.Minute)}},
+		Jitter:   durationpb.New(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after := mustParseTime(t, "2025-01-01T00:00:00Z")
+
+	times := mustNextTimes(t, compiled, "namespace-id-schedule", after, 5)
+	again := mustNextTimes(t, compiled, "namespace-id-schedule", after, 5)
+	jittered := false
+	for i, st := range times {
+		// Jitter is capped by the time to the following action.
+		if st.next.Before(st.nominal) || !st.next.Before(st.nominal.Add(time.Minute)) {
+			t.Errorf("time %d: jittered time %s outside of [%s, +1m)", i, st.next, st.nominal)
+		}
+		if !st.next.Equal(again[i].next) {
+			t.Errorf("time %d: jitter is not deterministic", i)
+		}
+		jittered = jittered || !st.next.Equal(st.nominal)
+	}
+	if !jittered {
+		t.Error("expected jitter to be applied")
+	}
+
+	for _, st := range mustNextTimes(t, compiled, "", after, 5) {
+		if !st.next.Equal(st.nominal) {
+			t.Errorf("expected no jitter without a seed, got %s for %s", st.next, st.nominal)
+		}
+	}
+}
+
+func TestCompiledSpec_NoTimes(t *testing.T) {
+	spec := &schedulev1.ScheduleSpec{
+		Calendar: []*schedulev1.CalendarSpec{{Year: "2020"}},
+	}
+
+	assertTimes(t, previewTimes(t, spec, "2025-01-01T00:00:00Z", 5))
+}
+
+func TestCompiledSpec_TooManyExcludedTimes(t *testing.T) {
+	compiled, err := compileScheduleSpec(&schedulev1.ScheduleSpec{
+		Interval:        []*schedulev1.IntervalSpec{{Interval: durationpb.New(time.Second)}},
+		ExcludeCalendar: []*schedulev1.CalendarSpec{{Second: "*", Minute: "*", Hour: "*"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := compiled.nextTimes("", mustParseTime(t, "2025-01-01T00:00:00Z"), 1); err == nil {
+		t.Fatal("expected an error when every time is excluded")
+	}
+}
+
+// TestSchedulePreviewDataSourceSchema verifies that the spec attribute converts from the
+// resource schema and that the result is a valid schema.
+func TestSchedulePreviewDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	var resp datasource.SchemaResponse
+	NewSchedulePreviewDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+	if !resp.Schema.Attributes["spec"].IsRequired() {
+		t.Error("spec must be required")
+	}
+}
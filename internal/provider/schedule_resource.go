@@ -1,27 +1,37 @@
 package provider
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
+	"slices"
 	"strings"
 	"time"
 
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"go.temporal.io/api/serviceerror"
@@ -34,6 +44,8 @@ import (
 	taskqueuev1 "go.temporal.io/api/taskqueue/v1"
 	workflowv1 "go.temporal.io/api/workflow/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -41,12 +53,31 @@ import (
 const (
 	// defaultCatchupWindow default time window for catching up on missed schedules.
 	defaultCatchupWindow = "5m"
+
+	// memoUpdateUnsupportedKey is the private state key set once the server rejected an
+	// in-place update of the schedule memo. Memo changes then require replacement.
+	memoUpdateUnsupportedKey = "memo_update_unsupported"
+
+	// conflictTokenKey is the private state key holding the conflict token of the schedule
+	// as last read, so Update does not overwrite changes made outside Terraform since then.
+	conflictTokenKey = "conflict_token"
+
+	// memoUpdateChecks and memoUpdateCheckInterval bound how long Update waits for
+	// a memo change to become visible.
+	memoUpdateChecks        = 5
+	memoUpdateCheckInterval = 500 * time.Millisecond
+
+	// pauseNote and unpauseNote are the notes sent to pause or unpause a schedule without
+	// configured notes, since a patch with empty notes leaves the schedule unchanged.
+	pauseNote   = "Paused by Terraform"
+	unpauseNote = "Unpaused by Terraform"
 )
 
 var (
 	_ resource.Resource                = &ScheduleResource{}
 	_ resource.ResourceWithConfigure   = &ScheduleResource{}
 	_ resource.ResourceWithImportState = &ScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &ScheduleResource{}
 )
 
 // ScheduleOverlapPolicy defines the valid overlap policy values.
@@ -61,24 +92,36 @@ var ScheduleOverlapPolicy = map[string]enums.ScheduleOverlapPolicy{
 
 // ScheduleResourceModel defines the data schema for a Temporal schedule resource.
 type ScheduleResourceModel struct {
-	Namespace  types.String         `tfsdk:"namespace"`
-	ScheduleID types.String         `tfsdk:"schedule_id"`
-	Memo       types.Map            `tfsdk:"memo"`
-	Spec       *ScheduleSpecModel   `tfsdk:"spec"`
-	Action     *ScheduleActionModel `tfsdk:"action"`
-	State      *ScheduleStateModel  `tfsdk:"state"`
-	Policy     *SchedulePolicyModel `tfsdk:"policy_config"`
+	Namespace  types.String `tfsdk:"namespace"`
+	ScheduleID types.String `tfsdk:"schedule_id"`
+	Memo       types.Map    `tfsdk:"memo"`
+	MemoJSON   types.Map    `tfsdk:"memo_json"`
+	MemoAll    types.Map    `tfsdk:"memo_all"`
+	// SearchAttributes are indexed on the schedule itself, e.g. to filter ListSchedules.
+	SearchAttributes types.Map            `tfsdk:"search_attributes"`
+	Spec             *ScheduleSpecModel   `tfsdk:"spec"`
+	Action           *ScheduleActionModel `tfsdk:"action"`
+	State            *ScheduleStateModel  `tfsdk:"state"`
+	// ManageState false leaves the schedule state to operators after creation.
+	ManageState types.Bool           `tfsdk:"manage_state"`
+	Policy      *SchedulePolicyModel `tfsdk:"policy_config"`
+	Info        types.Object         `tfsdk:"info"`
+	// InitialPatch is only sent when the schedule is created.
+	InitialPatch *ScheduleInitialPatchModel `tfsdk:"initial_patch"`
 }
 
 // ScheduleSpecModel defines the schedule specification.
 type ScheduleSpecModel struct {
-	Intervals     []IntervalModel `tfsdk:"intervals"`
-	CalendarItems []CalendarModel `tfsdk:"calendar_items"`
-	CronItems     []types.String  `tfsdk:"cron_items"`
-	StartTime     types.String    `tfsdk:"start_time"`
-	EndTime       types.String    `tfsdk:"end_time"`
-	Jitter        types.String    `tfsdk:"jitter"`
-	TimeZone      types.String    `tfsdk:"time_zone"`
+	Intervals                      []IntervalModel           `tfsdk:"intervals"`
+	CalendarItems                  []CalendarModel           `tfsdk:"calendar_items"`
+	ExcludeCalendarItems           []CalendarModel           `tfsdk:"exclude_calendar_items"`
+	StructuredCalendarItems        []StructuredCalendarModel `tfsdk:"structured_calendar_items"`
+	ExcludeStructuredCalendarItems []StructuredCalendarModel `tfsdk:"exclude_structured_calendar_items"`
+	CronItems                      []types.String            `tfsdk:"cron_items"`
+	StartTime                      types.String              `tfsdk:"start_time"`
+	EndTime                        types.String              `tfsdk:"end_time"`
+	Jitter                         types.String              `tfsdk:"jitter"`
+	TimeZone                       types.String              `tfsdk:"time_zone"`
 }
 
 // CalendarModel defines a calendar expression.
@@ -93,6 +136,25 @@ type CalendarModel struct {
 	Comment    types.String `tfsdk:"comment"`
 }
 
+// StructuredCalendarModel defines a calendar specification with explicit ranges.
+type StructuredCalendarModel struct {
+	Year       []RangeModel `tfsdk:"year"`
+	Month      []RangeModel `tfsdk:"month"`
+	DayOfMonth []RangeModel `tfsdk:"day_of_month"`
+	DayOfWeek  []RangeModel `tfsdk:"day_of_week"`
+	Hour       []RangeModel `tfsdk:"hour"`
+	Minute     []RangeModel `tfsdk:"minute"`
+	Second     []RangeModel `tfsdk:"second"`
+	Comment    types.String `tfsdk:"comment"`
+}
+
+// RangeModel defines an inclusive range of calendar values.
+type RangeModel struct {
+	Start types.Int64 `tfsdk:"start"`
+	End   types.Int64 `tfsdk:"end"`
+	Step  types.Int64 `tfsdk:"step"`
+}
+
 // IntervalModel defines an interval specification.
 type IntervalModel struct {
 	Every  types.String `tfsdk:"every"`
@@ -106,13 +168,35 @@ type ScheduleActionModel struct {
 
 // WorkflowActionModel defines the workflow action.
 type WorkflowActionModel struct {
-	WorkflowID       types.String `tfsdk:"workflow_id"`
-	WorkflowType     types.String `tfsdk:"workflow_type"`
-	TaskQueue        types.String `tfsdk:"task_queue"`
-	Input            types.String `tfsdk:"input"`
-	ExecutionTimeout types.String `tfsdk:"execution_timeout"`
-	RunTimeout       types.String `tfsdk:"run_timeout"`
-	TaskTimeout      types.String `tfsdk:"task_timeout"`
+	WorkflowID       types.String      `tfsdk:"workflow_id"`
+	WorkflowType     types.String      `tfsdk:"workflow_type"`
+	TaskQueue        types.String      `tfsdk:"task_queue"`
+	Input            jsonValue         `tfsdk:"input"`
+	Inputs           []jsonValue       `tfsdk:"inputs"`
+	ExecutionTimeout types.String      `tfsdk:"execution_timeout"`
+	RunTimeout       types.String      `tfsdk:"run_timeout"`
+	TaskTimeout      types.String      `tfsdk:"task_timeout"`
+	RetryPolicy      *RetryPolicyModel `tfsdk:"retry_policy"`
+	Memo             types.Map         `tfsdk:"memo"`
+	SearchAttributes types.Map         `tfsdk:"search_attributes"`
+	Header           types.Map         `tfsdk:"header"`
+	Priority         *PriorityModel    `tfsdk:"priority"`
+}
+
+// RetryPolicyModel defines the retry policy of the started workflow.
+type RetryPolicyModel struct {
+	InitialInterval        types.String   `tfsdk:"initial_interval"`
+	BackoffCoefficient     types.Float64  `tfsdk:"backoff_coefficient"`
+	MaximumInterval        types.String   `tfsdk:"maximum_interval"`
+	MaximumAttempts        types.Int64    `tfsdk:"maximum_attempts"`
+	NonRetryableErrorTypes []types.String `tfsdk:"non_retryable_error_types"`
+}
+
+// PriorityModel defines the priority and fairness settings of the started workflow.
+type PriorityModel struct {
+	PriorityKey    types.Int64   `tfsdk:"priority_key"`
+	FairnessKey    types.String  `tfsdk:"fairness_key"`
+	FairnessWeight types.Float64 `tfsdk:"fairness_weight"`
 }
 
 // ScheduleStateModel defines the state of a schedule.
@@ -130,6 +214,24 @@ type SchedulePolicyModel struct {
 	PauseOnFailure types.Bool   `tfsdk:"pause_on_failure"`
 }
 
+// ScheduleInitialPatchModel defines the actions taken once when a schedule is created.
+type ScheduleInitialPatchModel struct {
+	TriggerImmediately *TriggerImmediatelyModel `tfsdk:"trigger_immediately"`
+	Backfill           []BackfillModel          `tfsdk:"backfill"`
+}
+
+// TriggerImmediatelyModel defines an immediate run of the schedule action.
+type TriggerImmediatelyModel struct {
+	OverlapPolicy types.String `tfsdk:"overlap_policy"`
+}
+
+// BackfillModel defines a time range to backfill.
+type BackfillModel struct {
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	OverlapPolicy types.String `tfsdk:"overlap_policy"`
+}
+
 // NewScheduleResource creates a new instance of ScheduleResource.
 func NewScheduleResource() resource.Resource {
 	return &ScheduleResource{}
@@ -137,7 +239,11 @@ func NewScheduleResource() resource.Resource {
 
 // ScheduleResource implements the Temporal schedule resource.
 type ScheduleResource struct {
-	client grpc.ClientConnInterface
+	client      grpc.ClientConnInterface
+	namespace   string
+	defaultMemo map[string]string
+	codec       *payloadCodec
+	readOnly    bool
 }
 
 // Metadata sets the metadata for the schedule resource.
@@ -151,9 +257,11 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 		MarkdownDescription: "Temporal Schedule resource for managing workflow schedules",
 		Attributes: map[string]schema.Attribute{
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the schedule resides",
-				Required:            true,
+				MarkdownDescription: "Namespace where the schedule resides. If this is not provided, the provider namespace will be used",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -171,13 +279,36 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 				Validators: []validator.Map{
 					mapvalidator.SizeAtLeast(1),
 				},
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifier.RequiresReplace(),
+			},
+			"memo_json": schema.MapAttribute{
+				MarkdownDescription: "Memo entries with arbitrary JSON values, e.g. built with `jsonencode()`. Keys must not also be set in `memo`",
+				ElementType:         jsonType{},
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(validJSON()),
+				},
+			},
+			"memo_all": schema.MapAttribute{
+				MarkdownDescription: "String memo sent to Temporal: the provider `default_memo` merged with `memo`, without keys set in `memo_json`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"search_attributes": schema.MapAttribute{
+				MarkdownDescription: "Search attributes of the schedule itself, e.g. to filter `ListSchedules`. Values are encoded according to the registered search attribute type: numbers and booleans as literals, datetimes in RFC 3339 and keyword lists as a JSON array",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
 				},
 			},
+			"info": scheduleInfoAttribute(),
 			"spec": schema.SingleNestedAttribute{
 				MarkdownDescription: "Schedule specification",
 				Required:            true,
+				Validators: []validator.Object{
+					validScheduleSpec(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"intervals": schema.ListNestedAttribute{
 						MarkdownDescription: "Time intervals for schedule",
@@ -185,12 +316,18 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"every": schema.StringAttribute{
-									MarkdownDescription: "Duration of the interval (e.g., '24h', '7d')",
+									MarkdownDescription: "Duration of the interval (e.g., '24h', '168h')",
 									Required:            true,
+									Validators: []validator.String{
+										validPositiveDuration("Invalid Interval Duration"),
+									},
 								},
 								"offset": schema.StringAttribute{
 									MarkdownDescription: "Offset from the interval (e.g., '1h')",
 									Optional:            true,
+									Validators: []validator.String{
+										validDuration("Invalid Interval Offset"),
+									},
 								},
 							},
 						},
@@ -199,81 +336,68 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 						MarkdownDescription: "Calendar expressions for schedule",
 						Optional:            true,
 						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"year": schema.StringAttribute{
-									MarkdownDescription: "Year specification (e.g., '2022', '2022-2025')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("*"),
-								},
-								"month": schema.StringAttribute{
-									MarkdownDescription: "Month specification in numeric format (e.g., '1', '1,2,9', '1-12')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("*"),
-								},
-								"day_of_month": schema.StringAttribute{
-									MarkdownDescription: "Day of month specification (e.g., '1', '1,15', '1-31')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("*"),
-								},
-								"day_of_week": schema.StringAttribute{
-									MarkdownDescription: "Day of week specification in numeric format (e.g., '1', '1-6', '1,3,5')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("0-6"),
-								},
-								"hour": schema.StringAttribute{
-									MarkdownDescription: "Hour specification (e.g., '9', '9-17', '11-14')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("0"),
-								},
-								"minute": schema.StringAttribute{
-									MarkdownDescription: "Minute specification (e.g., '0', '0,30', '*/15', '*')",
-									Optional:            true,
-									Computed:            true,
-									Default:             stringdefault.StaticString("0"),
-								},
-								"second": schema.StringAttribute{
-									MarkdownDescription: "Second specification (e.g., '0', '0,30', '*')",
-									Computed:            true,
-									Optional:            true,
-									Default:             stringdefault.StaticString("0"),
-								},
-								"comment": schema.StringAttribute{
-									MarkdownDescription: "Optional comment describing this calendar entry",
-									Computed:            true,
-									Optional:            true,
-									Default:             stringdefault.StaticString(""),
-								},
-							},
+							Attributes: calendarItemAttributes(),
+						},
+					},
+					"exclude_calendar_items": schema.ListNestedAttribute{
+						MarkdownDescription: "Calendar expressions for times the schedule must not run at, e.g. holidays. They take precedence over intervals, calendar and cron items",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: calendarItemAttributes(),
+						},
+					},
+					"structured_calendar_items": schema.ListNestedAttribute{
+						MarkdownDescription: "Calendar specifications with explicit ranges instead of strings. Omitted fields default as in `calendar_items`",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: structuredCalendarItemAttributes(),
+						},
+					},
+					"exclude_structured_calendar_items": schema.ListNestedAttribute{
+						MarkdownDescription: "Calendar specifications with explicit ranges for times the schedule must not run at, like `exclude_calendar_items`. Omitted fields default as in `calendar_items`",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: structuredCalendarItemAttributes(),
 						},
 					},
 					"cron_items": schema.ListAttribute{
-						MarkdownDescription: "Traditional cron expressions (e.g. '15 8 * * *')",
+						MarkdownDescription: "Traditional cron expressions with 5, 6 or 7 fields (e.g. '15 8 * * *'), shorthands such as '@daily' or '@every 1h/5m', an optional 'CRON_TZ=<zone>' prefix, which replaces the default `time_zone`, and an optional '#' comment",
 						ElementType:         types.StringType,
 						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validCronString()),
+						},
 					},
 
 					"start_time": schema.StringAttribute{
 						MarkdownDescription: "Start time of the schedule (RFC3339)",
 						Optional:            true,
+						Validators: []validator.String{
+							validRFC3339("Invalid Start Time"),
+						},
 					},
 					"end_time": schema.StringAttribute{
 						MarkdownDescription: "End time of the schedule (RFC3339)",
 						Optional:            true,
+						Validators: []validator.String{
+							validRFC3339("Invalid End Time"),
+						},
 					},
 					"jitter": schema.StringAttribute{
 						MarkdownDescription: "Jitter duration to add randomness to scheduled times",
 						Optional:            true,
+						Validators: []validator.String{
+							validDuration("Invalid Jitter"),
+						},
 					},
 					"time_zone": schema.StringAttribute{
 						MarkdownDescription: "Time zone for the schedule",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("UTC"),
+						Validators: []validator.String{
+							validTimeZone(),
+						},
 					},
 				},
 			},
@@ -298,20 +422,131 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 								Required:            true,
 							},
 							"input": schema.StringAttribute{
-								MarkdownDescription: "Workflow input (JSON)",
+								MarkdownDescription: "Workflow input (JSON), passed as the single workflow argument",
+								CustomType:          jsonType{},
+								Optional:            true,
+								Validators: []validator.String{
+									validJSON(),
+									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("inputs")),
+								},
+							},
+							"inputs": schema.ListAttribute{
+								MarkdownDescription: "Workflow arguments (JSON), each passed as a separate argument",
+								ElementType:         jsonType{},
 								Optional:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+									listvalidator.ValueStringsAre(validJSON()),
+								},
 							},
 							"execution_timeout": schema.StringAttribute{
 								MarkdownDescription: "Execution timeout",
 								Optional:            true,
+								Validators: []validator.String{
+									validDuration("Invalid Execution Timeout"),
+								},
 							},
 							"run_timeout": schema.StringAttribute{
 								MarkdownDescription: "Run timeout",
 								Optional:            true,
+								Validators: []validator.String{
+									validDuration("Invalid Run Timeout"),
+								},
 							},
 							"task_timeout": schema.StringAttribute{
 								MarkdownDescription: "Task timeout",
 								Optional:            true,
+								Validators: []validator.String{
+									validDuration("Invalid Task Timeout"),
+								},
+							},
+							"retry_policy": schema.SingleNestedAttribute{
+								MarkdownDescription: "Retry policy of the started workflow",
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"initial_interval": schema.StringAttribute{
+										MarkdownDescription: "Interval of the first retry (e.g., '1s')",
+										Optional:            true,
+										Validators: []validator.String{
+											validDuration("Invalid Retry Initial Interval"),
+										},
+									},
+									"backoff_coefficient": schema.Float64Attribute{
+										MarkdownDescription: "Coefficient used to calculate the next retry interval",
+										Optional:            true,
+										Validators: []validator.Float64{
+											float64validator.AtLeast(1),
+										},
+									},
+									"maximum_interval": schema.StringAttribute{
+										MarkdownDescription: "Maximum interval between retries (e.g., '100s')",
+										Optional:            true,
+										Validators: []validator.String{
+											validDuration("Invalid Retry Maximum Interval"),
+										},
+									},
+									"maximum_attempts": schema.Int64Attribute{
+										MarkdownDescription: "Maximum number of attempts. Unlimited if not set",
+										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, math.MaxInt32),
+										},
+									},
+									"non_retryable_error_types": schema.ListAttribute{
+										MarkdownDescription: "Application error types that are not retried",
+										ElementType:         types.StringType,
+										Optional:            true,
+									},
+								},
+							},
+							"memo": schema.MapAttribute{
+								MarkdownDescription: "Non-indexed key-value pairs attached to each workflow run",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Map{
+									mapvalidator.SizeAtLeast(1),
+								},
+							},
+							"search_attributes": schema.MapAttribute{
+								MarkdownDescription: "Search attributes attached to each workflow run. Values are encoded according to the type registered " +
+									"in the namespace: e.g. '42' for Int, 'true' for Bool, RFC3339 for Datetime and a JSON array for KeywordList",
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.Map{
+									mapvalidator.SizeAtLeast(1),
+								},
+							},
+							"header": schema.MapAttribute{
+								MarkdownDescription: "Header fields passed to the workflow and its interceptors",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Map{
+									mapvalidator.SizeAtLeast(1),
+								},
+							},
+							"priority": schema.SingleNestedAttribute{
+								MarkdownDescription: "Priority and fairness of the started workflow",
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"priority_key": schema.Int64Attribute{
+										MarkdownDescription: "Priority key, lower numbers are higher priority",
+										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, math.MaxInt32),
+										},
+									},
+									"fairness_key": schema.StringAttribute{
+										MarkdownDescription: "Key used to balance task dispatch between groups of workflows",
+										Optional:            true,
+									},
+									"fairness_weight": schema.Float64Attribute{
+										MarkdownDescription: "Weight of the fairness key",
+										Optional:            true,
+										Validators: []validator.Float64{
+											float64validator.AtLeast(0),
+										},
+									},
+								},
 							},
 						},
 					},
@@ -322,7 +557,7 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:            true,
 				Attributes: map[string]schema.Attribute{
 					"paused": schema.BoolAttribute{
-						MarkdownDescription: "Pause the Schedule immediately on creation",
+						MarkdownDescription: "Whether the schedule is paused. Pausing or unpausing an existing schedule patches only its state",
 						Optional:            true,
 						Computed:            true,
 						Default:             booldefault.StaticBool(false),
@@ -340,13 +575,68 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 						Default:             int64default.StaticInt64(0),
 					},
 					"notes": schema.StringAttribute{
-						MarkdownDescription: "Initial notes",
+						MarkdownDescription: "Notes on the schedule state, e.g. why and by whom it was paused. Pausing or unpausing without notes records 'Paused by Terraform' or 'Unpaused by Terraform' on the schedule.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 					},
 				},
 			},
+			"manage_state": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform enforces `state` after creation. When false, `state` is only applied when the schedule " +
+					"is created, e.g. so that schedules paused by operators in the Temporal UI stay paused: changes to `state` are stored " +
+					"but not sent, and the current state is reflected in `info`. Defaults to true",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"initial_patch": schema.SingleNestedAttribute{
+				MarkdownDescription: "Actions taken once when the schedule is created, e.g. to cover the days before a new schedule was " +
+					"introduced. Changing it afterwards has no effect: the value used at creation is kept. Setting it on an existing " +
+					"schedule, e.g. an imported one, only stores it",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{initialPatchPlanModifier{}},
+				Attributes: map[string]schema.Attribute{
+					"trigger_immediately": schema.SingleNestedAttribute{
+						MarkdownDescription: "Run the schedule action immediately after creation",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"overlap_policy": schema.StringAttribute{
+								MarkdownDescription: "Policy for the triggered workflow. Defaults to `policy_config.overlap_policy`. " +
+									"Accepted values: Skip, BufferOne, BufferAll, CancelOther, TerminateOther, AllowAll",
+								Optional:   true,
+								Validators: scheduleActionOverlapPolicyValidators(),
+							},
+						},
+					},
+					"backfill": schema.ListNestedAttribute{
+						MarkdownDescription: "Time ranges to take the actions the schedule would have taken in, had it existed",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"start_time": schema.StringAttribute{
+									MarkdownDescription: "Start of the range (RFC3339)",
+									Required:            true,
+									Validators:          []validator.String{validRFC3339("Invalid Start Time")},
+								},
+								"end_time": schema.StringAttribute{
+									MarkdownDescription: "End of the range (RFC3339)",
+									Required:            true,
+									Validators:          []validator.String{validRFC3339("Invalid End Time")},
+								},
+								"overlap_policy": schema.StringAttribute{
+									MarkdownDescription: "Policy for backfilled workflows overlapping each other or running workflows. " +
+										"Defaults to `policy_config.overlap_policy`. Accepted values: Skip, BufferOne, BufferAll, CancelOther, TerminateOther, AllowAll",
+									Optional:   true,
+									Validators: scheduleActionOverlapPolicyValidators(),
+								},
+							},
+							Validators: []validator.Object{validTimeRange()},
+						},
+					},
+				},
+			},
 			"policy_config": schema.SingleNestedAttribute{
 				MarkdownDescription: "Schedule policy configuration",
 				Required:            true,
@@ -372,6 +662,9 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(defaultCatchupWindow),
+						Validators: []validator.String{
+							validDuration("Invalid Catchup Window"),
+						},
 					},
 					"pause_on_failure": schema.BoolAttribute{
 						MarkdownDescription: "Pause the schedule on action failure",
@@ -385,6 +678,144 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+// structuredCalendarItemAttributes returns the attributes of a calendar specification with
+// explicit ranges.
+func structuredCalendarItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"year":         rangeListAttribute(calendarYear, "Years, 2000-2100. Omit to match every year"),
+		"month":        rangeListAttribute(calendarMonth, "Months, 1-12. Defaults to every month"),
+		"day_of_month": rangeListAttribute(calendarDayOfMonth, "Days of month, 1-31. Defaults to every day"),
+		"day_of_week":  rangeListAttribute(calendarDayOfWeek, "Days of week, 0-6 with 0 as Sunday. Defaults to every day"),
+		"hour":         rangeListAttribute(calendarHour, "Hours, 0-23. Defaults to 0"),
+		"minute":       rangeListAttribute(calendarMinute, "Minutes, 0-59. Defaults to 0"),
+		"second":       rangeListAttribute(calendarSecond, "Seconds, 0-59. Defaults to 0"),
+		"comment": schema.StringAttribute{
+			MarkdownDescription: "Optional comment describing this calendar entry",
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+	}
+}
+
+// rangeListAttribute returns a list of inclusive ranges of the values of field.
+func rangeListAttribute(field calendarField, description string) schema.ListNestedAttribute {
+	upper := int64(field.max)
+	if field.sundaySeven {
+		upper = 7
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start": schema.Int64Attribute{
+					MarkdownDescription: "First value of the range",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(int64(field.min), upper),
+					},
+				},
+				"end": schema.Int64Attribute{
+					MarkdownDescription: "Last value of the range (inclusive). Defaults to `start`",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(int64(field.min), upper),
+						int64validator.AtLeastSumOf(path.MatchRelative().AtParent().AtName("start")),
+					},
+				},
+				"step": schema.Int64Attribute{
+					MarkdownDescription: "Step between values of the range. Defaults to 1",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
+// calendarItemAttributes returns the attributes of a calendar expression. Included and
+// excluded calendar items share them, including their defaults.
+func calendarItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"year": schema.StringAttribute{
+			MarkdownDescription: "Year specification (e.g., '2022', '2022-2025')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("*"),
+			Validators: []validator.String{
+				validCalendarField(calendarYear),
+			},
+		},
+		"month": schema.StringAttribute{
+			MarkdownDescription: "Month specification in numeric format (e.g., '1', '1,2,9', '1-12')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("*"),
+			Validators: []validator.String{
+				validCalendarField(calendarMonth),
+			},
+		},
+		"day_of_month": schema.StringAttribute{
+			MarkdownDescription: "Day of month specification (e.g., '1', '1,15', '1-31')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("*"),
+			Validators: []validator.String{
+				validCalendarField(calendarDayOfMonth),
+			},
+		},
+		"day_of_week": schema.StringAttribute{
+			MarkdownDescription: "Day of week specification in numeric format (e.g., '1', '1-6', '1,3,5')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("0-6"),
+			Validators: []validator.String{
+				validCalendarField(calendarDayOfWeek),
+			},
+		},
+		"hour": schema.StringAttribute{
+			MarkdownDescription: "Hour specification (e.g., '9', '9-17', '11-14')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("0"),
+			Validators: []validator.String{
+				validCalendarField(calendarHour),
+			},
+		},
+		"minute": schema.StringAttribute{
+			MarkdownDescription: "Minute specification (e.g., '0', '0,30', '*/15', '*')",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("0"),
+			Validators: []validator.String{
+				validCalendarField(calendarMinute),
+			},
+		},
+		"second": schema.StringAttribute{
+			MarkdownDescription: "Second specification (e.g., '0', '0,30', '*')",
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString("0"),
+			Validators: []validator.String{
+				validCalendarField(calendarSecond),
+			},
+		},
+		"comment": schema.StringAttribute{
+			MarkdownDescription: "Optional comment describing this calendar entry",
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+	}
+}
+
 // Configure sets up the schedule resource configuration.
 func (r *ScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Temporal Schedule Resource")
@@ -393,21 +824,102 @@ func (r *ScheduleResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(grpc.ClientConnInterface)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Conn
+	r.namespace = providerData.Namespace
+	r.defaultMemo = providerData.DefaultMemo
+	r.codec = providerData.PayloadCodec
+	r.readOnly = providerData.ReadOnly
 	tflog.Info(ctx, "Configured Temporal Schedule client", map[string]any{"success": true})
 }
 
+// ModifyPlan fills in the provider namespace when the schedule does not set one
+// and plans memo_all from the provider default memo and the schedule memo.
+func (r *ScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderNamespace(ctx, r.namespace, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memo, memoJSON types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("memo"), &memo)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("memo_json"), &memoJSON)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memoAll, diags := mergeMemo(ctx, r.defaultMemo, memo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// memo_json entries take precedence over default memo entries with the same key.
+	switch {
+	case memoJSON.IsUnknown():
+		memoAll = types.MapUnknown(types.StringType)
+	case !memoJSON.IsNull() && !memoAll.IsUnknown():
+		for key := range memoJSON.Elements() {
+			if _, ok := memo.Elements()[key]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("memo_json").AtMapKey(key),
+					"Duplicate Memo Key",
+					fmt.Sprintf("Memo key %s is set in both memo and memo_json. Set each key in only one of them.", key),
+				)
+			}
+		}
+		elements := make(map[string]attr.Value, len(memoAll.Elements()))
+		for key, value := range memoAll.Elements() {
+			if _, ok := memoJSON.Elements()[key]; !ok {
+				elements[key] = value
+			}
+		}
+		memoAll = types.MapValueMust(types.StringType, elements)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("memo_all"), memoAll)...)
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memo changes are applied in place unless the server is known not to support it.
+	unsupported, diags := req.Private.GetKey(ctx, memoUpdateUnsupportedKey)
+	resp.Diagnostics.Append(diags...)
+	if unsupported == nil {
+		return
+	}
+
+	var stateMemoAll, stateMemoJSON types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("memo_all"), &stateMemoAll)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("memo_json"), &stateMemoJSON)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !memoAll.Equal(stateMemoAll) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("memo_all"))
+	}
+	if !memoJSON.Equal(stateMemoJSON) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("memo_json"))
+	}
+}
+
 // Create creates a new schedule in Temporal.
 func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "create the schedule", &resp.Diagnostics) {
+		return
+	}
+
 	var data ScheduleResourceModel
 
 	client := workflowservice.NewWorkflowServiceClient(r.client)
@@ -418,13 +930,21 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	codec := r.codec.withNamespace(data.Namespace.ValueString())
+
 	scheduleSpec, diags := convertToScheduleSpec(data.Spec)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	scheduleAction, diags := convertToScheduleAction(data.Action)
+	saTypes, diags := r.searchAttributeTypes(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleAction, diags := convertToScheduleAction(ctx, codec, data.Action, saTypes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -436,7 +956,13 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	memo, diags := convertToMemo(ctx, data.Memo)
+	memo, diags := convertToScheduleMemo(ctx, codec, data.MemoAll, data.MemoJSON)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchAttributes, diags := convertToSearchAttributes(ctx, data.SearchAttributes, saTypes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -448,11 +974,18 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	initialPatch, diags := convertToInitialPatch(data.InitialPatch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := &workflowservice.CreateScheduleRequest{
-		RequestId:  u,
-		Namespace:  data.Namespace.ValueString(),
-		ScheduleId: data.ScheduleID.ValueString(),
-		Memo:       memo,
+		RequestId:        u,
+		Namespace:        data.Namespace.ValueString(),
+		ScheduleId:       data.ScheduleID.ValueString(),
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
 		Schedule: &schedulev1.Schedule{
 			Spec:   scheduleSpec,
 			Action: scheduleAction,
@@ -465,11 +998,12 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 			// Policies: &schedulev1.SchedulePolicies{},
 			Policies: policy,
 		},
+		InitialPatch: initialPatch,
 	}
 
 	_, err = client.CreateSchedule(ctx, request)
 	if err != nil {
-		if _, ok := err.(*serviceerror.AlreadyExists); ok {
+		if _, ok := toServiceError(err).(*serviceerror.AlreadyExists); ok {
 			resp.Diagnostics.AddError(
 				"Schedule Already Exists",
 				fmt.Sprintf("A schedule with ID %s already exists in namespace %s: %s",
@@ -481,6 +1015,9 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	data.Info, diags = r.readScheduleInfo(ctx, data.Namespace.ValueString(), data.ScheduleID.ValueString())
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -493,7 +1030,6 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 // Read reads the current state of a schedule.
 func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ScheduleResourceModel
-	var diags diag.Diagnostics
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -509,58 +1045,124 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	describeResp, err := client.DescribeSchedule(ctx, describeReq)
 	if err != nil {
+		if isScheduleNotFound(err) {
+			// The schedule was deleted outside Terraform, e.g. from the Temporal UI.
+			tflog.Warn(ctx, "Schedule not found, removing from state", map[string]any{
+				"namespace": data.Namespace.ValueString(), "schedule_id": data.ScheduleID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Schedule",
 			fmt.Sprintf("Could not read schedule %s: %s", data.ScheduleID.ValueString(), err.Error()),
 		)
 		return
 	}
-	if describeResp.Memo != nil {
-		data.Memo, diags = convertMemo(ctx, describeResp.GetMemo())
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+
+	resp.Diagnostics.Append(r.refreshFromDescription(ctx, describeResp, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(storeConflictToken(ctx, resp.Private, describeResp.GetConflictToken())...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+}
+
+// refreshFromDescription sets the attributes of data read from a described schedule. The
+// prior values in data decide the form values are read back in, e.g. calendar strings,
+// memo_json keys and unmanaged state.
+func (r *ScheduleResource) refreshFromDescription(ctx context.Context, describeResp *workflowservice.DescribeScheduleResponse, data *ScheduleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	codec := r.codec.withNamespace(data.Namespace.ValueString())
+
+	memoAll, memoJSON, fieldDiags := convertScheduleMemo(ctx, codec, describeResp.GetMemo(), data.MemoJSON)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.MemoJSON = memoJSON
+	data.Memo, fieldDiags = stripDefaultMemo(ctx, r.defaultMemo, memoAll, data.Memo)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.MemoAll = memoAll
+	data.Info, fieldDiags = convertScheduleInfo(ctx, describeResp.GetInfo(), describeResp.GetSchedule().GetState())
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.SearchAttributes, fieldDiags = convertSearchAttributes(scheduleSearchAttributes(describeResp.GetSearchAttributes(), data.SearchAttributes), data.SearchAttributes)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return diags
 	}
 	if describeResp.Schedule != nil {
 		if describeResp.Schedule.Spec != nil {
-			data.Spec = convertScheduleSpec(describeResp.Schedule.Spec)
+			data.Spec = convertScheduleSpec(describeResp.Schedule.Spec, data.Spec)
 		}
 
 		if describeResp.Schedule.Action != nil {
-			data.Action = convertScheduleAction(describeResp.Schedule.Action)
+			action, fieldDiags := convertScheduleAction(ctx, codec, describeResp.Schedule.Action, data.Action)
+			diags.Append(fieldDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			keepInputsForm(data.Action, action)
+			data.Action = action
 		}
 
-		data.State = &ScheduleStateModel{
-			Paused:           types.BoolValue(describeResp.Schedule.State.GetPaused()),
-			LimitedActions:   types.BoolValue(describeResp.Schedule.State.GetLimitedActions()),
-			RemainingActions: types.Int64Value(describeResp.Schedule.State.GetRemainingActions()),
-			Notes:            types.StringValue(describeResp.Schedule.State.GetNotes()),
+		// Schedules imported or created before manage_state existed have their state managed.
+		if data.ManageState.IsNull() {
+			data.ManageState = types.BoolValue(true)
 		}
-
-		if describeResp.Schedule.Policies != nil {
-			data.Policy = &SchedulePolicyModel{
-				Overlap:        types.StringValue(describeResp.Schedule.Policies.OverlapPolicy.String()),
-				CatchupWindow:  types.StringValue(formatDurationCanonical(describeResp.Schedule.Policies.CatchupWindow)),
-				PauseOnFailure: types.BoolValue(describeResp.Schedule.Policies.GetPauseOnFailure()),
+		// Unmanaged state keeps its configured values; the current state is in info.
+		if data.ManageState.ValueBool() || data.State == nil {
+			prior := data.State
+			data.State = convertScheduleState(describeResp.Schedule.State)
+			// The default notes of a pause or unpause patch are not drift from empty notes.
+			if notes := data.State.Notes.ValueString(); prior != nil && prior.Notes.ValueString() == "" &&
+				(notes == pauseNote || notes == unpauseNote) {
+				data.State.Notes = prior.Notes
 			}
 		}
-	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		// policy_config is required, so schedules created without policies read back the defaults.
+		data.Policy = convertSchedulePolicy(describeResp.Schedule.Policies, data.Policy)
+	}
 
+	return diags
 }
 
 // Update updates an existing schedule.
 func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ScheduleResourceModel
+	if rejectReadOnly(ctx, r.readOnly, "update the schedule", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state ScheduleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// initial_patch is only sent at creation, so setting it afterwards needs no request.
+	patchOnly, diags := scheduleChangesOnly(ctx, req.Plan, req.State, "initial_patch", "info")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if patchOnly {
+		r.finishUpdate(ctx, &data, resp)
+		return
+	}
 
 	client := workflowservice.NewWorkflowServiceClient(r.client)
+	codec := r.codec.withNamespace(data.Namespace.ValueString())
 
 	scheduleSpec, diags := convertToScheduleSpec(data.Spec)
 	resp.Diagnostics.Append(diags...)
@@ -568,7 +1170,13 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	scheduleAction, diags := convertToScheduleAction(data.Action)
+	saTypes, diags := r.searchAttributeTypes(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleAction, diags := convertToScheduleAction(ctx, codec, data.Action, saTypes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -586,25 +1194,123 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	request := &workflowservice.UpdateScheduleRequest{
-		RequestId:  u,
+	// The schedule is read once, so the conflict token checked and sent with the update
+	// belongs to the same snapshot as the state and memo entries kept from the server.
+	describeResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
 		Namespace:  data.Namespace.ValueString(),
 		ScheduleId: data.ScheduleID.ValueString(),
-		Schedule: &schedulev1.Schedule{
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Schedule",
+			fmt.Sprintf("Could not read schedule %s: %s", data.ScheduleID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkConflictToken(ctx, req.Private, data.ScheduleID.ValueString(), describeResp.GetConflictToken())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pausing, unpausing or changing the notes alone is done with a patch, leaving the rest
+	// of the schedule untouched on the server. Unmanaged state changes need no request.
+	stateOnly, diags := scheduleChangesOnly(ctx, req.Plan, req.State, "state", "manage_state", "info", "initial_patch")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if patch := schedulePausePatch(data.State, state.State); stateOnly && (patch != nil || !data.ManageState.ValueBool()) {
+		if data.ManageState.ValueBool() {
+			_, err = client.PatchSchedule(ctx, &workflowservice.PatchScheduleRequest{
+				RequestId:  u,
+				Namespace:  data.Namespace.ValueString(),
+				ScheduleId: data.ScheduleID.ValueString(),
+				Patch:      patch,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Schedule",
+					fmt.Sprintf("Could not patch schedule %s: %s", data.ScheduleID.ValueString(), err.Error()),
+				)
+				return
+			}
+		}
+
+		r.finishUpdate(ctx, &data, resp)
+		return
+	}
+
+	scheduleState := &schedulev1.ScheduleState{
+		Paused:           data.State.Paused.ValueBool(),
+		LimitedActions:   data.State.LimitedActions.ValueBool(),
+		RemainingActions: data.State.RemainingActions.ValueInt64(),
+		Notes:            data.State.Notes.ValueString(),
+	}
+	if !data.ManageState.ValueBool() {
+		// The schedule is replaced as a whole, so send back its current state.
+		scheduleState = describeResp.GetSchedule().GetState()
+	}
+
+	request := &workflowservice.UpdateScheduleRequest{
+		RequestId:     u,
+		Namespace:     data.Namespace.ValueString(),
+		ScheduleId:    data.ScheduleID.ValueString(),
+		ConflictToken: describeResp.GetConflictToken(),
+		Schedule: &schedulev1.Schedule{
 			Spec:     scheduleSpec,
 			Action:   scheduleAction,
 			Policies: policy,
-			State: &schedulev1.ScheduleState{
-				Paused:           data.State.Paused.ValueBool(),
-				LimitedActions:   data.State.LimitedActions.ValueBool(),
-				RemainingActions: data.State.RemainingActions.ValueInt64(),
-				Notes:            data.State.Notes.ValueString(),
-			},
+			State:    scheduleState,
 		},
 	}
 
+	// Only replace the memo when it changed, so entries are not rewritten on every update.
+	if !data.MemoAll.Equal(state.MemoAll) || !data.MemoJSON.Equal(state.MemoJSON) {
+		request.Memo, diags = convertToScheduleMemo(ctx, codec, data.MemoAll, data.MemoJSON)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The memo is replaced as a whole: keep entries Terraform cannot represent.
+		unreadable, err := unreadableMemoFields(ctx, codec, describeResp.GetMemo())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Schedule Memo",
+				fmt.Sprintf("Could not read the memo of schedule %s: %s", data.ScheduleID.ValueString(), err.Error()),
+			)
+			return
+		}
+		for key, payload := range unreadable {
+			if _, ok := request.Memo.Fields[key]; !ok {
+				request.Memo.Fields[key] = payload
+			}
+		}
+	}
+
+	// Search attributes are also replaced as a whole; an empty object removes them all.
+	if !data.SearchAttributes.Equal(state.SearchAttributes) {
+		request.SearchAttributes, diags = convertToSearchAttributes(ctx, data.SearchAttributes, saTypes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if request.SearchAttributes == nil {
+			request.SearchAttributes = &commonv1.SearchAttributes{}
+		}
+	}
+
 	_, err = client.UpdateSchedule(ctx, request)
 	if err != nil {
+		if request.Memo != nil && isMemoUpdateUnsupported(err) {
+			r.memoUpdateUnsupported(ctx, resp)
+			return
+		}
+		if isConflictTokenMismatch(err) {
+			resp.Diagnostics.Append(scheduleChangedDiagnostic(data.ScheduleID.ValueString()))
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Updating Schedule",
 			fmt.Sprintf("Could not update schedule %s: %s", data.ScheduleID.ValueString(), err.Error()),
@@ -612,14 +1318,73 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// The schedule workflow applies the memo asynchronously, so wait briefly for it.
+	if request.Memo != nil {
+		resp.Diagnostics.Append(r.checkMemoApplied(ctx, resp.Private, data.Namespace.ValueString(), data.ScheduleID.ValueString(), request.Memo)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.finishUpdate(ctx, &data, resp)
+}
+
+// finishUpdate stores an updated schedule along with its refreshed info.
+func (r *ScheduleResource) finishUpdate(ctx context.Context, data *ScheduleResourceModel, resp *resource.UpdateResponse) {
+	// The token changes with the update, so the next update relies on the next read.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, conflictTokenKey, nil)...)
+
+	var diags diag.Diagnostics
+	data.Info, diags = r.readScheduleInfo(ctx, data.Namespace.ValueString(), data.ScheduleID.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Updated schedule: %s in namespace: %s",
 		data.ScheduleID.ValueString(), data.Namespace.ValueString()))
 }
 
+// scheduleChangesOnly reports whether the plan changes no root attribute but the named ones.
+func scheduleChangesOnly(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, names ...string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for name := range plan.Schema.GetAttributes() {
+		if slices.Contains(names, name) {
+			continue
+		}
+
+		var planned, prior attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &prior)...)
+		if diags.HasError() || !planned.Equal(prior) {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// schedulePausePatch returns the patch pausing or unpausing the schedule with the planned
+// notes, or nil when the state change cannot be made with a patch, which cannot change
+// action limits. A patch with empty notes changes nothing, so empty notes are sent as
+// pauseNote or unpauseNote.
+func schedulePausePatch(planned, prior *ScheduleStateModel) *schedulev1.SchedulePatch {
+	if planned == nil || prior == nil ||
+		!planned.LimitedActions.Equal(prior.LimitedActions) || !planned.RemainingActions.Equal(prior.RemainingActions) {
+		return nil
+	}
+
+	if planned.Paused.ValueBool() {
+		return &schedulev1.SchedulePatch{Pause: cmp.Or(planned.Notes.ValueString(), pauseNote)}
+	}
+	return &schedulev1.SchedulePatch{Unpause: cmp.Or(planned.Notes.ValueString(), unpauseNote)}
+}
+
 // Delete deletes a schedule.
 func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "delete the schedule", &resp.Diagnostics) {
+		return
+	}
+
 	var data ScheduleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -635,6 +1400,12 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	_, err := client.DeleteSchedule(ctx, deleteReq)
+	if isScheduleNotFound(err) {
+		tflog.Warn(ctx, "Schedule already deleted", map[string]any{
+			"namespace": data.Namespace.ValueString(), "schedule_id": data.ScheduleID.ValueString(),
+		})
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Schedule",
@@ -651,15 +1422,15 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Expected request ID format is either 'namespace:schedule_id' or 'schedule_id'
 	// Ex: 'default:example_schedule' or 'example_schedule'
-	// If no namespace is provided, 'default' will be used
+	// If no namespace is provided, the provider namespace will be used
 
 	var namespace, schedule string
 
 	idTokens := strings.Split(req.ID, ":")
 	switch len(idTokens) {
 	case 1:
-		// One part: schedule ID with default namespace ('example_schedule')
-		namespace = "default"
+		// One part: schedule ID with the provider namespace ('example_schedule')
+		namespace = r.namespace
 		schedule = idTokens[0]
 	case 2:
 		// Two parts: the first is namespace, second is schedule ID ('default:schedule_example')
@@ -671,9 +1442,8 @@ func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	// Validate the imported resource exists
 	client := workflowservice.NewWorkflowServiceClient(r.client)
-	_, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+	describeResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
 		Namespace:  namespace,
 		ScheduleId: schedule,
 	})
@@ -685,12 +1455,252 @@ func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), schedule)...)
+	// Import the complete schedule, so the plan right after import is empty and
+	// -generate-config-out writes the schedule as it is.
+	data := importedScheduleModel(namespace, schedule)
+	resp.Diagnostics.Append(r.refreshFromDescription(ctx, describeResp, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(storeConflictToken(ctx, resp.Private, describeResp.GetConflictToken())...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// memoUpdateUnsupported records that the server cannot update the schedule memo in place,
+// so the next plan replaces the schedule to change its memo.
+func (r *ScheduleResource) memoUpdateUnsupported(ctx context.Context, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, memoUpdateUnsupportedKey, []byte("true"))...)
+	resp.Diagnostics.AddError(
+		"Schedule Memo Update Not Supported",
+		"The Temporal server cannot update the memo of an existing schedule. "+
+			"Run terraform apply again: memo changes on this schedule are now planned as a replacement.",
+	)
+}
+
+// importedScheduleModel returns the model an imported schedule is read into. Memo entries
+// are read as memo unless they are not strings, and the state is managed.
+func importedScheduleModel(namespace, scheduleID string) ScheduleResourceModel {
+	return ScheduleResourceModel{
+		Namespace:        types.StringValue(namespace),
+		ScheduleID:       types.StringValue(scheduleID),
+		Memo:             types.MapNull(types.StringType),
+		MemoJSON:         types.MapNull(jsonType{}),
+		SearchAttributes: types.MapNull(types.StringType),
+		ManageState:      types.BoolValue(true),
+	}
+}
+
+// privateStateGetter reads provider-defined private state of a resource.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter writes provider-defined private state of a resource.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// storeConflictToken stores the conflict token of a read for the next update.
+func storeConflictToken(ctx context.Context, private privateStateSetter, token []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, err := json.Marshal(token)
+	if err != nil {
+		diags.AddError("Error Storing Conflict Token", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, conflictTokenKey, value)
+}
+
+// checkConflictToken checks that the schedule has not changed since the last read, by
+// comparing the conflict token stored by that read with the current one. Schedules read
+// before tokens were stored, or last written by this provider, have no token and are
+// updated unconditionally.
+func checkConflictToken(ctx context.Context, private privateStateGetter, scheduleID string, current []byte) diag.Diagnostics {
+	stored, diags := private.GetKey(ctx, conflictTokenKey)
+	if diags.HasError() || stored == nil {
+		return diags
+	}
+
+	var token []byte
+	if err := json.Unmarshal(stored, &token); err != nil || len(token) == 0 {
+		return diags
+	}
+
+	// Older servers drop a conflicting update without an error, so compare the tokens
+	// first instead of relying on UpdateSchedule alone.
+	if !bytes.Equal(current, token) {
+		diags.Append(scheduleChangedDiagnostic(scheduleID))
+	}
+
+	return diags
+}
+
+// isScheduleNotFound reports whether err means that the schedule does not exist.
+func isScheduleNotFound(err error) bool {
+	_, ok := toServiceError(err).(*serviceerror.NotFound)
+	return ok
+}
+
+// isConflictTokenMismatch reports whether an UpdateSchedule error means the conflict token
+// no longer matches the schedule.
+func isConflictTokenMismatch(err error) bool {
+	switch toServiceError(err).(type) {
+	case *serviceerror.FailedPrecondition, *serviceerror.Aborted:
+		return strings.Contains(strings.ToLower(err.Error()), "conflict")
+	}
+	return false
+}
+
+// scheduleChangedDiagnostic reports that a schedule changed between plan and apply.
+func scheduleChangedDiagnostic(scheduleID string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Schedule Changed Outside Terraform",
+		fmt.Sprintf("Schedule %s was changed outside Terraform since it was last read, e.g. paused or edited in the Temporal UI. "+
+			"Re-plan to review the changes and apply again.", scheduleID),
+	)
+}
+
+// waitForMemo reports whether the schedule memo matches memo, polling briefly
+// because the memo is updated asynchronously by the schedule workflow.
+func (r *ScheduleResource) waitForMemo(ctx context.Context, namespace, scheduleID string, memo *commonv1.Memo) (bool, error) {
+	client := workflowservice.NewWorkflowServiceClient(r.client)
+
+	for i := 0; i < memoUpdateChecks; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-time.After(memoUpdateCheckInterval):
+			}
+		}
+
+		describeResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+			Namespace:  namespace,
+			ScheduleId: scheduleID,
+		})
+		if err != nil {
+			return false, err
+		}
+		if memoFieldsEqual(describeResp.GetMemo(), memo) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkMemoApplied waits for the schedule memo to match memo after an update. A memo that
+// is not applied in time is only reported, since a slow server applies it later; servers
+// rejecting memo updates are detected from the UpdateSchedule error instead. A confirmed
+// update clears an earlier record of memo updates being unsupported.
+func (r *ScheduleResource) checkMemoApplied(ctx context.Context, private privateStateSetter, namespace, scheduleID string, memo *commonv1.Memo) diag.Diagnostics {
+	var diags diag.Diagnostics
+	applied, err := r.waitForMemo(ctx, namespace, scheduleID, memo)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Schedule Memo",
+			fmt.Sprintf("Could not read the memo of schedule %s: %s", scheduleID, err.Error()),
+		)
+		return diags
+	}
+	if applied {
+		return private.SetKey(ctx, memoUpdateUnsupportedKey, nil)
+	}
+
+	diags.AddWarning(
+		"Schedule Memo Not Yet Updated",
+		fmt.Sprintf("The memo of schedule %s did not change within %s of the update. "+
+			"If the server ignores memo updates, the next plan shows the memo change again.",
+			scheduleID, memoUpdateChecks*memoUpdateCheckInterval),
+	)
+	return diags
+}
+
+// memoFieldsEqual reports whether two memos hold the same payloads.
+func memoFieldsEqual(a, b *commonv1.Memo) bool {
+	if len(a.GetFields()) != len(b.GetFields()) {
+		return false
+	}
+	for key, payload := range a.GetFields() {
+		if !proto.Equal(payload, b.GetFields()[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMemoUpdateUnsupported reports whether an UpdateSchedule error means the server
+// rejected the memo rather than the rest of the schedule.
+func isMemoUpdateUnsupported(err error) bool {
+	switch toServiceError(err).(type) {
+	case *serviceerror.Unimplemented:
+		return true
+	case *serviceerror.InvalidArgument:
+		return strings.Contains(strings.ToLower(err.Error()), "memo")
+	}
+	return false
+}
+
+// toServiceError converts the gRPC status errors returned by the workflow service client
+// to the matching service error types. Other errors are returned unchanged.
+func toServiceError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return serviceerror.FromStatus(st)
+	}
+	return err
+}
+
+// unreadableMemoFields returns the entries of a schedule memo that are not JSON, such as
+// binary or protobuf payloads written by SDKs, in their stored form.
+func unreadableMemoFields(ctx context.Context, codec *payloadCodec, memo *commonv1.Memo) (map[string]*commonv1.Payload, error) {
+	keys, payloads := sortedPayloads(memo.GetFields())
+	decoded, err := codec.decode(ctx, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadable := make(map[string]*commonv1.Payload)
+	for i, payload := range decoded {
+		if _, _, ok := decodeJSONPayload(payload); !ok {
+			unreadable[keys[i]] = payloads[i]
+		}
+	}
+
+	return unreadable, nil
+}
+
+// searchAttributeTypes looks up the registered search attribute types when the schedule
+// or its workflow action sets search attributes.
+func (r *ScheduleResource) searchAttributeTypes(ctx context.Context, data *ScheduleResourceModel) (map[string]enums.IndexedValueType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	action := data.Action
+	if data.SearchAttributes.IsNull() && (action == nil || action.Workflow == nil || action.Workflow.SearchAttributes.IsNull()) {
+		return nil, diags
+	}
+
+	namespace := data.Namespace.ValueString()
+
+	saTypes, err := listSearchAttributeTypes(ctx, r.client, namespace)
+	if err != nil {
+		diags.AddError(
+			"Error Listing Search Attributes",
+			fmt.Sprintf("Could not list search attributes in namespace %s: %s", namespace, err.Error()),
+		)
+		return nil, diags
+	}
+
+	return saTypes, diags
 }
 
 // Helper funcs.
-func convertToScheduleAction(actionModel *ScheduleActionModel) (*schedulev1.ScheduleAction, diag.Diagnostics) {
+// Workflow inputs, memo and header payloads are encoded with codec; search attributes are left as is
+// so Temporal can index them.
+func convertToScheduleAction(ctx context.Context, codec *payloadCodec, actionModel *ScheduleActionModel, saTypes map[string]enums.IndexedValueType) (*schedulev1.ScheduleAction, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if actionModel == nil || actionModel.Workflow == nil {
@@ -713,17 +1723,16 @@ func convertToScheduleAction(actionModel *ScheduleActionModel) (*schedulev1.Sche
 		TaskQueue:    taskQueue,
 	}
 
+	inputs := actionModel.Workflow.Inputs
 	if !actionModel.Workflow.Input.IsNull() {
-		workflowAction.Input = &commonv1.Payloads{
-			Payloads: []*commonv1.Payload{
-				{
-					Metadata: map[string][]byte{
-						"encoding": []byte("json/plain"),
-					},
-					Data: []byte(actionModel.Workflow.Input.ValueString()),
-				},
-			},
+		inputs = []jsonValue{actionModel.Workflow.Input}
+	}
+	if len(inputs) > 0 {
+		input, err := convertToInputPayloads(ctx, codec, inputs)
+		if err != nil {
+			diags.AddError("Invalid Workflow Input", fmt.Sprintf("Unable to encode workflow input: %s", err))
 		}
+		workflowAction.Input = input
 	}
 
 	if !actionModel.Workflow.ExecutionTimeout.IsNull() {
@@ -762,6 +1771,34 @@ func convertToScheduleAction(actionModel *ScheduleActionModel) (*schedulev1.Sche
 		workflowAction.WorkflowTaskTimeout = durationpb.New(taskTimeout)
 	}
 
+	if actionModel.Workflow.RetryPolicy != nil {
+		retryPolicy, retryDiags := convertToRetryPolicy(actionModel.Workflow.RetryPolicy)
+		diags.Append(retryDiags...)
+		workflowAction.RetryPolicy = retryPolicy
+	}
+
+	if !actionModel.Workflow.Memo.IsNull() {
+		memo, memoDiags := convertToMemo(ctx, codec, actionModel.Workflow.Memo)
+		diags.Append(memoDiags...)
+		workflowAction.Memo = memo
+	}
+
+	if !actionModel.Workflow.SearchAttributes.IsNull() {
+		searchAttributes, saDiags := convertToSearchAttributes(ctx, actionModel.Workflow.SearchAttributes, saTypes)
+		diags.Append(saDiags...)
+		workflowAction.SearchAttributes = searchAttributes
+	}
+
+	if !actionModel.Workflow.Header.IsNull() {
+		fields, headerDiags := convertToPayloadFields(ctx, codec, actionModel.Workflow.Header)
+		diags.Append(headerDiags...)
+		workflowAction.Header = &commonv1.Header{Fields: fields}
+	}
+
+	if actionModel.Workflow.Priority != nil {
+		workflowAction.Priority = convertToPriority(actionModel.Workflow.Priority)
+	}
+
 	action.Action = &schedulev1.ScheduleAction_StartWorkflow{
 		StartWorkflow: workflowAction,
 	}
@@ -809,28 +1846,32 @@ func convertToScheduleSpec(specModel *ScheduleSpecModel) (*schedulev1.ScheduleSp
 	}
 
 	// Convert Calendar specifications
-	if len(specModel.CalendarItems) > 0 {
-		spec.Calendar = make([]*schedulev1.CalendarSpec, 0, len(specModel.CalendarItems))
-		for _, e := range specModel.CalendarItems {
-			cal := &schedulev1.CalendarSpec{
-				Second:     e.Second.ValueString(),
-				Minute:     e.Minute.ValueString(),
-				Hour:       e.Hour.ValueString(),
-				DayOfWeek:  e.DayOfWeek.ValueString(),
-				DayOfMonth: e.DayOfMonth.ValueString(),
-				Month:      e.Month.ValueString(),
-				Year:       e.Year.ValueString(),
-				Comment:    e.Comment.ValueString(),
-			}
-			spec.Calendar = append(spec.Calendar, cal)
+	spec.Calendar = convertToCalendarSpecs(specModel.CalendarItems)
+	spec.ExcludeCalendar = convertToCalendarSpecs(specModel.ExcludeCalendarItems)
+	spec.StructuredCalendar = convertToStructuredCalendars(specModel.StructuredCalendarItems)
+	spec.ExcludeStructuredCalendar = convertToStructuredCalendars(specModel.ExcludeStructuredCalendarItems)
+
+	// Cron expressions are sent as is and converted by Temporal. They are only parsed here
+	// to validate them and to find their time zone.
+	cronTimeZone := ""
+	for _, e := range specModel.CronItems {
+		cron, err := parseCronString(e.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid Cron Expression",
+				fmt.Sprintf("Unable to parse cron expression: %s. Error: %s", e.ValueString(), err),
+			)
+			continue
 		}
-	}
-
-	// Set cron expression if provided
-	if len(specModel.CronItems) > 0 {
-		spec.CronString = make([]string, 0, len(specModel.CronItems))
-		for _, e := range specModel.CronItems {
-			spec.CronString = append(spec.CronString, e.ValueString())
+		spec.CronString = append(spec.CronString, e.ValueString())
+		if cron.timeZone != "" {
+			if cronTimeZone != "" && cronTimeZone != cron.timeZone {
+				diags.AddError(
+					"Conflicting Time Zone",
+					fmt.Sprintf("Cron expressions set different time zones: %s and %s", cronTimeZone, cron.timeZone),
+				)
+			}
+			cronTimeZone = cron.timeZone
 		}
 	}
 
@@ -878,77 +1919,98 @@ func convertToScheduleSpec(specModel *ScheduleSpecModel) (*schedulev1.ScheduleSp
 		spec.TimezoneName = specModel.TimeZone.ValueString()
 	}
 
+	// A CRON_TZ= prefix sets the time zone of the whole spec in place of the default UTC.
+	// The planned time_zone is "UTC" whether it is set or not, so a time_zone set in the
+	// config is checked against CRON_TZ= by validScheduleSpec instead.
+	if cronTimeZone != "" {
+		if spec.TimezoneName != "" && spec.TimezoneName != "UTC" && spec.TimezoneName != cronTimeZone {
+			diags.AddError(
+				"Conflicting Time Zone",
+				fmt.Sprintf("Cron expressions set time zone %s, but time_zone is %s", cronTimeZone, spec.TimezoneName),
+			)
+		}
+		spec.TimezoneName = cronTimeZone
+	}
+
 	return spec, diags
 }
 
+// ConvertFromTemporalSchedule converts a Temporal schedule to the resource model the way
+// an imported schedule is read. The memo and search attributes are not part of the
+// schedule itself and are left null.
 func ConvertFromTemporalSchedule(scheduleID string, namespace string, schedule *schedulev1.Schedule) (*ScheduleResourceModel, error) {
 	if schedule == nil {
 		return nil, fmt.Errorf("schedule description is nil")
 	}
-	model := &ScheduleResourceModel{
-		Namespace:  types.StringValue(namespace),
-		ScheduleID: types.StringValue(scheduleID),
-	}
-
-	if schedule.Spec != nil {
-		model.Spec = convertScheduleSpec(schedule.Spec)
-	}
 
-	if schedule.Action != nil {
-		model.Action = convertScheduleAction(schedule.Action)
+	model := importedScheduleModel(namespace, scheduleID)
+	diags := (&ScheduleResource{}).refreshFromDescription(context.Background(), &workflowservice.DescribeScheduleResponse{Schedule: schedule}, &model)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert schedule: %v", diags.Errors())
 	}
 
-	return model, nil
+	return &model, nil
 }
 
-// convertScheduleSpec converts Temporal ScheduleSpec to Terraform model.
-func convertScheduleSpec(spec *schedulev1.ScheduleSpec) *ScheduleSpecModel {
+// convertScheduleSpec converts Temporal ScheduleSpec to Terraform model. Calendar fields
+// matching the same values as in prior, if set, keep their configured form.
+func convertScheduleSpec(spec *schedulev1.ScheduleSpec, prior *ScheduleSpecModel) *ScheduleSpecModel {
 	if spec == nil {
 		return nil
 	}
+	if prior == nil {
+		prior = &ScheduleSpecModel{}
+	}
 
 	tfSpec := &ScheduleSpecModel{
 		TimeZone: types.StringValue(spec.TimezoneName),
 	}
 
-	// Convert cron expressions
-	if len(spec.CronString) > 0 {
-		tfSpec.CronItems = make([]types.String, 0, len(spec.CronString))
-		for _, cron := range spec.CronString {
-			tfSpec.CronItems = append(tfSpec.CronItems, types.StringValue(cron))
-		}
-	}
+	// Temporal stores calendar strings and cron expressions in their structured form, after
+	// the structured calendars. Cron expressions are matched by their structured form, since
+	// their order relative to the calendar strings is up to the server; the intervals of
+	// "@every" cron expressions follow the configured intervals.
+	structured := spec.StructuredCalendar
+	n := min(len(prior.StructuredCalendarItems), len(structured))
+	tfSpec.StructuredCalendarItems = convertStructuredCalendarItems(structured[:n], prior.StructuredCalendarItems)
+	structured = structured[n:]
 
-	// Convert calendar specs
-	if len(spec.StructuredCalendar) > 0 {
-		tfSpec.CalendarItems = make([]CalendarModel, 0, len(spec.Calendar))
-		for _, calendar := range spec.StructuredCalendar {
-			tfCalendar := CalendarModel{
-				Year:       types.StringValue(formatRanges(calendar.Year)),
-				Month:      types.StringValue(formatRanges(calendar.Month)),
-				DayOfMonth: types.StringValue(formatRanges(calendar.DayOfMonth)),
-				DayOfWeek:  types.StringValue(formatRanges(calendar.DayOfWeek)),
-				Hour:       types.StringValue(formatRanges(calendar.Hour)),
-				Minute:     types.StringValue(formatRanges(calendar.Minute)),
-				Second:     types.StringValue(formatRanges(calendar.Second)),
-				Comment:    types.StringValue(calendar.Comment),
-			}
-			tfSpec.CalendarItems = append(tfSpec.CalendarItems, tfCalendar)
-		}
+	intervals := spec.Interval
+	n = min(len(prior.Intervals), len(intervals))
+	cronIntervals := intervals[n:]
+	intervals = intervals[:n]
+
+	tfSpec.CronItems, structured, cronIntervals = convertCronItems(prior.CronItems, structured, cronIntervals)
+	intervals = append(intervals, cronIntervals...)
+	for _, cron := range spec.CronString {
+		tfSpec.CronItems = append(tfSpec.CronItems, types.StringValue(cron))
 	}
 
-	// If calendar items are being converted to cron, log it
-	if len(spec.Calendar) == 0 && len(spec.CronString) > 0 {
-		tflog.Warn(context.Background(), "Calendar items may have been converted to cron strings by Temporal")
+	tfSpec.CalendarItems = convertStructuredCalendars(structured, prior.CalendarItems)
+
+	// Excluded calendar strings are likewise stored after the excluded structured calendars.
+	excludes := spec.ExcludeStructuredCalendar
+	n = min(len(prior.ExcludeStructuredCalendarItems), len(excludes))
+	tfSpec.ExcludeStructuredCalendarItems = convertStructuredCalendarItems(excludes[:n], prior.ExcludeStructuredCalendarItems)
+	tfSpec.ExcludeCalendarItems = convertStructuredCalendars(excludes[n:], prior.ExcludeCalendarItems)
+
+	// A CRON_TZ= prefix replaces the default time zone.
+	if tz := cronTimeZone(prior.CronItems); tz != "" && tz == spec.TimezoneName && !prior.TimeZone.IsNull() {
+		tfSpec.TimeZone = prior.TimeZone
 	}
-	if len(spec.Interval) > 0 {
-		tfSpec.Intervals = make([]IntervalModel, 0, len(spec.Interval))
-		for _, interval := range spec.Interval {
+
+	if len(intervals) > 0 {
+		tfSpec.Intervals = make([]IntervalModel, 0, len(intervals))
+		for i, interval := range intervals {
+			var priorInterval IntervalModel
+			if i < len(prior.Intervals) {
+				priorInterval = prior.Intervals[i]
+			}
 			tfInterval := IntervalModel{
-				Every: types.StringValue(formatDurationCanonical(interval.Interval)),
+				Every: durationValue(priorInterval.Every, interval.Interval),
 			}
 			if interval.Phase != nil {
-				tfInterval.Offset = types.StringValue(formatDurationCanonical(interval.Phase))
+				tfInterval.Offset = durationValue(priorInterval.Offset, interval.Phase)
 			}
 			tfSpec.Intervals = append(tfSpec.Intervals, tfInterval)
 		}
@@ -962,18 +2024,304 @@ func convertScheduleSpec(spec *schedulev1.ScheduleSpec) *ScheduleSpecModel {
 	}
 
 	if spec.Jitter != nil {
-		tfSpec.Jitter = types.StringValue(formatDurationCanonical(spec.Jitter))
+		tfSpec.Jitter = durationValue(prior.Jitter, spec.Jitter)
 	}
 
 	return tfSpec
 }
 
-// convertScheduleAction converts Temporal ScheduleAction to Terraform model.
-func convertScheduleAction(action *schedulev1.ScheduleAction) *ScheduleActionModel {
-	if action == nil {
+// convertCronItems matches the prior cron expressions against the structured calendars and
+// intervals Temporal stores them as, keeping each expression that still matches. An expression
+// without a match takes the first calendar or interval left, read back as a cron expression.
+// It returns the cron expressions and the calendars and intervals left over.
+func convertCronItems(prior []types.String, calendars []*schedulev1.StructuredCalendarSpec, intervals []*schedulev1.IntervalSpec) ([]types.String, []*schedulev1.StructuredCalendarSpec, []*schedulev1.IntervalSpec) {
+	var (
+		items      []types.String
+		crons      = make([]*cronSpec, len(prior))
+		calendarAt = make([]int, len(prior))
+		intervalAt = make([]int, len(prior))
+	)
+	calendars = slices.Clone(calendars)
+	intervals = slices.Clone(intervals)
+
+	// Take the matching calendars and intervals first, so that an expression changed outside
+	// Terraform does not take the calendar of another expression.
+	for i, p := range prior {
+		calendarAt[i], intervalAt[i] = -1, -1
+		cron, err := parseCronString(p.ValueString())
+		if err != nil {
+			continue
+		}
+		crons[i] = cron
+		if cron.calendar != nil {
+			calendarAt[i] = slices.IndexFunc(calendars, func(c *schedulev1.StructuredCalendarSpec) bool {
+				return c != nil && cron.matches(c, nil)
+			})
+			if calendarAt[i] >= 0 {
+				calendars[calendarAt[i]] = nil
+			}
+		} else {
+			intervalAt[i] = slices.IndexFunc(intervals, func(iv *schedulev1.IntervalSpec) bool {
+				return iv != nil && cron.matches(nil, iv)
+			})
+			if intervalAt[i] >= 0 {
+				intervals[intervalAt[i]] = nil
+			}
+		}
+	}
+
+	for i, p := range prior {
+		cron := crons[i]
+		switch {
+		case cron == nil:
+		case calendarAt[i] >= 0 || intervalAt[i] >= 0:
+			items = append(items, p)
+		case cron.calendar != nil:
+			if j := slices.IndexFunc(calendars, func(c *schedulev1.StructuredCalendarSpec) bool { return c != nil }); j >= 0 {
+				items = append(items, types.StringValue(formatCronCalendar(calendars[j])))
+				calendars[j] = nil
+			}
+		default:
+			if j := slices.IndexFunc(intervals, func(iv *schedulev1.IntervalSpec) bool { return iv != nil }); j >= 0 {
+				items = append(items, types.StringValue(formatCronInterval(intervals[j])))
+				intervals[j] = nil
+			}
+		}
+	}
+
+	return items, slices.DeleteFunc(calendars, func(c *schedulev1.StructuredCalendarSpec) bool { return c == nil }),
+		slices.DeleteFunc(intervals, func(iv *schedulev1.IntervalSpec) bool { return iv == nil })
+}
+
+// cronTimeZone returns the time zone set by a CRON_TZ= prefix of the cron expressions.
+func cronTimeZone(items []types.String) string {
+	for _, item := range items {
+		if cron, err := parseCronString(item.ValueString()); err == nil && cron.timeZone != "" {
+			return cron.timeZone
+		}
+	}
+
+	return ""
+}
+
+// convertToStructuredCalendars converts calendar specifications with explicit ranges to
+// Temporal structured calendar specs, filling omitted fields with the calendar defaults.
+func convertToStructuredCalendars(items []StructuredCalendarModel) []*schedulev1.StructuredCalendarSpec {
+	if len(items) == 0 {
+		return nil
+	}
+
+	calendars := make([]*schedulev1.StructuredCalendarSpec, 0, len(items))
+	for _, e := range items {
+		calendars = append(calendars, &schedulev1.StructuredCalendarSpec{
+			Second:     convertToRanges(calendarSecond, e.Second),
+			Minute:     convertToRanges(calendarMinute, e.Minute),
+			Hour:       convertToRanges(calendarHour, e.Hour),
+			DayOfMonth: convertToRanges(calendarDayOfMonth, e.DayOfMonth),
+			Month:      convertToRanges(calendarMonth, e.Month),
+			Year:       convertToRanges(calendarYear, e.Year),
+			DayOfWeek:  convertToRanges(calendarDayOfWeek, e.DayOfWeek),
+			Comment:    e.Comment.ValueString(),
+		})
+	}
+
+	return calendars
+}
+
+// convertToRanges converts explicit ranges to Temporal ranges. A nil list yields the field default.
+func convertToRanges(field calendarField, items []RangeModel) []*schedulev1.Range {
+	if items == nil {
+		ranges, _ := field.parse("")
+		return ranges
+	}
+
+	ranges := make([]*schedulev1.Range, 0, len(items))
+	for _, item := range items {
+		r := &schedulev1.Range{
+			Start: int32(item.Start.ValueInt64()),
+			End:   int32(item.Start.ValueInt64()),
+			Step:  1,
+		}
+		if !item.End.IsNull() {
+			r.End = int32(item.End.ValueInt64())
+		}
+		if !item.Step.IsNull() {
+			r.Step = int32(item.Step.ValueInt64())
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges
+}
+
+// convertToCalendarSpecs converts calendar expressions to Temporal calendar specs.
+func convertToCalendarSpecs(items []CalendarModel) []*schedulev1.CalendarSpec {
+	if len(items) == 0 {
+		return nil
+	}
+
+	calendars := make([]*schedulev1.CalendarSpec, 0, len(items))
+	for _, e := range items {
+		calendars = append(calendars, &schedulev1.CalendarSpec{
+			Second:     e.Second.ValueString(),
+			Minute:     e.Minute.ValueString(),
+			Hour:       e.Hour.ValueString(),
+			DayOfWeek:  e.DayOfWeek.ValueString(),
+			DayOfMonth: e.DayOfMonth.ValueString(),
+			Month:      e.Month.ValueString(),
+			Year:       e.Year.ValueString(),
+			Comment:    e.Comment.ValueString(),
+		})
+	}
+
+	return calendars
+}
+
+// defaultCalendarItem holds the defaults of the calendar expression attributes.
+var defaultCalendarItem = CalendarModel{
+	Year:       types.StringValue("*"),
+	Month:      types.StringValue("*"),
+	DayOfMonth: types.StringValue("*"),
+	DayOfWeek:  types.StringValue("0-6"),
+	Hour:       types.StringValue("0"),
+	Minute:     types.StringValue("0"),
+	Second:     types.StringValue("0"),
+}
+
+// convertStructuredCalendars converts Temporal structured calendar specs to calendar expressions.
+// Fields matching the same values as the prior expression at the same position keep its form.
+func convertStructuredCalendars(calendars []*schedulev1.StructuredCalendarSpec, prior []CalendarModel) []CalendarModel {
+	if len(calendars) == 0 {
+		return nil
+	}
+
+	items := make([]CalendarModel, 0, len(calendars))
+	for i, calendar := range calendars {
+		// Without a prior expression, e.g. on import, fields matching the defaults read
+		// back as the defaults.
+		p := defaultCalendarItem
+		if i < len(prior) {
+			p = prior[i]
+		}
+		items = append(items, CalendarModel{
+			Year:       types.StringValue(calendarYear.keepCalendarString(p.Year.ValueString(), calendar.Year)),
+			Month:      types.StringValue(calendarMonth.keepCalendarString(p.Month.ValueString(), calendar.Month)),
+			DayOfMonth: types.StringValue(calendarDayOfMonth.keepCalendarString(p.DayOfMonth.ValueString(), calendar.DayOfMonth)),
+			DayOfWeek:  types.StringValue(calendarDayOfWeek.keepCalendarString(p.DayOfWeek.ValueString(), calendar.DayOfWeek)),
+			Hour:       types.StringValue(calendarHour.keepCalendarString(p.Hour.ValueString(), calendar.Hour)),
+			Minute:     types.StringValue(calendarMinute.keepCalendarString(p.Minute.ValueString(), calendar.Minute)),
+			Second:     types.StringValue(calendarSecond.keepCalendarString(p.Second.ValueString(), calendar.Second)),
+			Comment:    types.StringValue(calendar.Comment),
+		})
+	}
+
+	return items
+}
+
+// convertStructuredCalendarItems converts Temporal structured calendar specs to calendar
+// specifications with explicit ranges, keeping the prior form of equivalent fields.
+func convertStructuredCalendarItems(calendars []*schedulev1.StructuredCalendarSpec, prior []StructuredCalendarModel) []StructuredCalendarModel {
+	if len(calendars) == 0 {
+		return nil
+	}
+
+	items := make([]StructuredCalendarModel, 0, len(calendars))
+	for i, calendar := range calendars {
+		var p StructuredCalendarModel
+		if i < len(prior) {
+			p = prior[i]
+		}
+		items = append(items, StructuredCalendarModel{
+			Year:       convertRanges(calendarYear, p.Year, calendar.Year),
+			Month:      convertRanges(calendarMonth, p.Month, calendar.Month),
+			DayOfMonth: convertRanges(calendarDayOfMonth, p.DayOfMonth, calendar.DayOfMonth),
+			DayOfWeek:  convertRanges(calendarDayOfWeek, p.DayOfWeek, calendar.DayOfWeek),
+			Hour:       convertRanges(calendarHour, p.Hour, calendar.Hour),
+			Minute:     convertRanges(calendarMinute, p.Minute, calendar.Minute),
+			Second:     convertRanges(calendarSecond, p.Second, calendar.Second),
+			Comment:    types.StringValue(calendar.Comment),
+		})
+	}
+
+	return items
+}
+
+// convertRanges converts Temporal ranges to explicit ranges. The prior ranges, or their
+// absence when the field was left to its default, are kept if they match the same values.
+func convertRanges(field calendarField, prior []RangeModel, ranges []*schedulev1.Range) []RangeModel {
+	if field.equal(convertToRanges(field, prior), ranges) {
+		return prior
+	}
+	if len(ranges) == 0 && field.name == calendarYear.name {
+		// No year ranges match every year.
 		return nil
 	}
 
+	items := make([]RangeModel, 0, len(ranges))
+	for _, r := range ranges {
+		item := RangeModel{
+			Start: types.Int64Value(int64(r.Start)),
+			End:   types.Int64Null(),
+			Step:  types.Int64Null(),
+		}
+		if r.End > r.Start {
+			item.End = types.Int64Value(int64(r.End))
+		}
+		if r.Step > 1 {
+			item.Step = types.Int64Value(int64(r.Step))
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// convertScheduleState converts Temporal ScheduleState to Terraform model.
+func convertScheduleState(state *schedulev1.ScheduleState) *ScheduleStateModel {
+	return &ScheduleStateModel{
+		Paused:           types.BoolValue(state.GetPaused()),
+		LimitedActions:   types.BoolValue(state.GetLimitedActions()),
+		RemainingActions: types.Int64Value(state.GetRemainingActions()),
+		Notes:            types.StringValue(state.GetNotes()),
+	}
+}
+
+// convertSchedulePolicy converts Temporal SchedulePolicies to Terraform model. Unset values
+// read back as values the scheduler treats the same way: an unspecified overlap policy as
+// Skip and a missing catch-up window as zero, i.e. the server default.
+func convertSchedulePolicy(policies *schedulev1.SchedulePolicies, prior *SchedulePolicyModel) *SchedulePolicyModel {
+	if prior == nil {
+		prior = &SchedulePolicyModel{}
+	}
+	overlap := policies.GetOverlapPolicy()
+	if overlap == enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		overlap = enums.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	catchupWindow := policies.GetCatchupWindow()
+	if catchupWindow == nil {
+		catchupWindow = durationpb.New(0)
+	}
+
+	return &SchedulePolicyModel{
+		Overlap:        types.StringValue(overlap.String()),
+		CatchupWindow:  durationValue(prior.CatchupWindow, catchupWindow),
+		PauseOnFailure: types.BoolValue(policies.GetPauseOnFailure()),
+	}
+}
+
+// convertScheduleAction converts Temporal ScheduleAction to Terraform model,
+// decoding workflow inputs, memo and header payloads with codec. Durations and search
+// attribute values equal to those of prior keep their prior form.
+func convertScheduleAction(ctx context.Context, codec *payloadCodec, action *schedulev1.ScheduleAction, prior *ScheduleActionModel) (*ScheduleActionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if action == nil {
+		return nil, diags
+	}
+	priorWorkflow := &WorkflowActionModel{SearchAttributes: types.MapNull(types.StringType)}
+	if prior != nil && prior.Workflow != nil {
+		priorWorkflow = prior.Workflow
+	}
+
 	tfAction := &ScheduleActionModel{}
 	workflowAction := action.GetStartWorkflow()
 	if workflowAction != nil {
@@ -986,45 +2334,238 @@ func convertScheduleAction(action *schedulev1.ScheduleAction) *ScheduleActionMod
 			tfWorkflow.WorkflowType = types.StringValue(workflowAction.WorkflowType.Name)
 		}
 
-		if workflowAction.Input != nil && len(workflowAction.Input.Payloads) > 0 {
-			tfWorkflow.Input = types.StringValue(string(workflowAction.Input.Payloads[0].Data))
-		} else {
-			tfWorkflow.Input = types.StringNull()
+		payloads, err := codec.decode(ctx, workflowAction.GetInput().GetPayloads())
+		if err != nil {
+			diags.AddError("Invalid Workflow Input", fmt.Sprintf("Unable to decode workflow input: %s", err))
+			return nil, diags
+		}
+
+		// A single argument reads back as input, several as inputs.
+		tfWorkflow.Input = jsonNull()
+		switch len(payloads) {
+		case 0:
+		case 1:
+			tfWorkflow.Input = jsonStringValue(string(payloads[0].GetData()))
+		default:
+			tfWorkflow.Inputs = make([]jsonValue, 0, len(payloads))
+			for _, payload := range payloads {
+				tfWorkflow.Inputs = append(tfWorkflow.Inputs, jsonStringValue(string(payload.GetData())))
+			}
 		}
 
 		if workflowAction.WorkflowExecutionTimeout != nil {
-			tfWorkflow.ExecutionTimeout = types.StringValue(formatDurationCanonical(workflowAction.WorkflowExecutionTimeout))
+			tfWorkflow.ExecutionTimeout = durationValue(priorWorkflow.ExecutionTimeout, workflowAction.WorkflowExecutionTimeout)
 		}
 		if workflowAction.WorkflowRunTimeout != nil {
-			tfWorkflow.RunTimeout = types.StringValue(formatDurationCanonical(workflowAction.WorkflowRunTimeout))
+			tfWorkflow.RunTimeout = durationValue(priorWorkflow.RunTimeout, workflowAction.WorkflowRunTimeout)
 		}
 		if workflowAction.WorkflowTaskTimeout != nil {
-			tfWorkflow.TaskTimeout = types.StringValue(formatDurationCanonical(workflowAction.WorkflowTaskTimeout))
+			tfWorkflow.TaskTimeout = durationValue(priorWorkflow.TaskTimeout, workflowAction.WorkflowTaskTimeout)
 		}
 
+		tfWorkflow.RetryPolicy = convertRetryPolicy(workflowAction.RetryPolicy, priorWorkflow.RetryPolicy)
+		tfWorkflow.Priority = convertPriority(workflowAction.Priority)
+
+		var fieldDiags diag.Diagnostics
+		tfWorkflow.Memo, fieldDiags = convertMemo(ctx, codec, workflowAction.Memo)
+		diags.Append(fieldDiags...)
+		tfWorkflow.SearchAttributes, fieldDiags = convertSearchAttributes(workflowAction.SearchAttributes, priorWorkflow.SearchAttributes)
+		diags.Append(fieldDiags...)
+		tfWorkflow.Header, fieldDiags = convertPayloadFields(ctx, codec, workflowAction.GetHeader().GetFields())
+		diags.Append(fieldDiags...)
+
 		tfAction.Workflow = tfWorkflow
 	}
 
-	return tfAction
+	return tfAction, diags
+}
+
+// convertToInputPayloads converts JSON workflow arguments to one json/plain payload each,
+// encoded with codec.
+func convertToInputPayloads(ctx context.Context, codec *payloadCodec, inputs []jsonValue) (*commonv1.Payloads, error) {
+	payloads := make([]*commonv1.Payload, 0, len(inputs))
+	for _, input := range inputs {
+		payloads = append(payloads, &commonv1.Payload{
+			Metadata: map[string][]byte{
+				"encoding": []byte("json/plain"),
+			},
+			Data: []byte(input.ValueString()),
+		})
+	}
+
+	payloads, err := codec.encode(ctx, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commonv1.Payloads{Payloads: payloads}, nil
+}
+
+// keepInputsForm reads a single workflow argument back into inputs when the prior
+// state used inputs, so a one-element inputs list does not drift to input.
+func keepInputsForm(prior, current *ScheduleActionModel) {
+	if prior == nil || prior.Workflow == nil || current == nil || current.Workflow == nil {
+		return
+	}
+	if prior.Workflow.Inputs != nil && !current.Workflow.Input.IsNull() {
+		current.Workflow.Inputs = []jsonValue{current.Workflow.Input}
+		current.Workflow.Input = jsonNull()
+	}
 }
 
-func convertMemo(ctx context.Context, memo *commonv1.Memo) (types.Map, diag.Diagnostics) {
+// convertToRetryPolicy converts a RetryPolicyModel to a Temporal API RetryPolicy.
+func convertToRetryPolicy(policyModel *RetryPolicyModel) (*commonv1.RetryPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	if memo == nil || len(memo.Fields) == 0 {
-		return basetypes.MapValue{}, nil
+
+	policy := &commonv1.RetryPolicy{
+		BackoffCoefficient: policyModel.BackoffCoefficient.ValueFloat64(),
+		MaximumAttempts:    int32(policyModel.MaximumAttempts.ValueInt64()),
 	}
 
-	data := make(map[string]string)
+	if !policyModel.InitialInterval.IsNull() {
+		initialInterval, err := time.ParseDuration(policyModel.InitialInterval.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid Retry Initial Interval",
+				fmt.Sprintf("Unable to parse retry initial interval: %s. Error: %s", policyModel.InitialInterval.ValueString(), err),
+			)
+		} else {
+			policy.InitialInterval = durationpb.New(initialInterval)
+		}
+	}
+
+	if !policyModel.MaximumInterval.IsNull() {
+		maximumInterval, err := time.ParseDuration(policyModel.MaximumInterval.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid Retry Maximum Interval",
+				fmt.Sprintf("Unable to parse retry maximum interval: %s. Error: %s", policyModel.MaximumInterval.ValueString(), err),
+			)
+		} else {
+			policy.MaximumInterval = durationpb.New(maximumInterval)
+		}
+	}
+
+	for _, errorType := range policyModel.NonRetryableErrorTypes {
+		policy.NonRetryableErrorTypes = append(policy.NonRetryableErrorTypes, errorType.ValueString())
+	}
+
+	return policy, diags
+}
+
+// convertRetryPolicy converts a Temporal RetryPolicy to Terraform model. Unset fields stay null,
+// and intervals equal to those of prior keep their prior form.
+func convertRetryPolicy(policy *commonv1.RetryPolicy, prior *RetryPolicyModel) *RetryPolicyModel {
+	if policy == nil {
+		return nil
+	}
+	if prior == nil {
+		prior = &RetryPolicyModel{}
+	}
+
+	tfPolicy := &RetryPolicyModel{}
+	if policy.InitialInterval != nil {
+		tfPolicy.InitialInterval = durationValue(prior.InitialInterval, policy.InitialInterval)
+	}
+	if policy.MaximumInterval != nil {
+		tfPolicy.MaximumInterval = durationValue(prior.MaximumInterval, policy.MaximumInterval)
+	}
+	if policy.BackoffCoefficient != 0 {
+		tfPolicy.BackoffCoefficient = types.Float64Value(policy.BackoffCoefficient)
+	}
+	if policy.MaximumAttempts != 0 {
+		tfPolicy.MaximumAttempts = types.Int64Value(int64(policy.MaximumAttempts))
+	}
+	if len(policy.NonRetryableErrorTypes) > 0 {
+		tfPolicy.NonRetryableErrorTypes = make([]types.String, 0, len(policy.NonRetryableErrorTypes))
+		for _, errorType := range policy.NonRetryableErrorTypes {
+			tfPolicy.NonRetryableErrorTypes = append(tfPolicy.NonRetryableErrorTypes, types.StringValue(errorType))
+		}
+	}
+
+	return tfPolicy
+}
+
+// convertToPriority converts a PriorityModel to a Temporal API Priority.
+func convertToPriority(priorityModel *PriorityModel) *commonv1.Priority {
+	return &commonv1.Priority{
+		PriorityKey:    int32(priorityModel.PriorityKey.ValueInt64()),
+		FairnessKey:    priorityModel.FairnessKey.ValueString(),
+		FairnessWeight: float32(priorityModel.FairnessWeight.ValueFloat64()),
+	}
+}
+
+// convertPriority converts a Temporal Priority to Terraform model. Unset fields stay null.
+func convertPriority(priority *commonv1.Priority) *PriorityModel {
+	if priority == nil {
+		return nil
+	}
+
+	tfPriority := &PriorityModel{}
+	if priority.PriorityKey != 0 {
+		tfPriority.PriorityKey = types.Int64Value(int64(priority.PriorityKey))
+	}
+	if priority.FairnessKey != "" {
+		tfPriority.FairnessKey = types.StringValue(priority.FairnessKey)
+	}
+	if priority.FairnessWeight != 0 {
+		// The weight is stored as float32; format it with float32 precision so
+		// e.g. 0.1 reads back as 0.1 rather than 0.10000000149011612.
+		weight, _ := strconv.ParseFloat(strconv.FormatFloat(float64(priority.FairnessWeight), 'g', -1, 32), 64)
+		tfPriority.FairnessWeight = types.Float64Value(weight)
+	}
+
+	return tfPriority
+}
+
+func convertMemo(ctx context.Context, codec *payloadCodec, memo *commonv1.Memo) (types.Map, diag.Diagnostics) {
+	return convertPayloadFields(ctx, codec, memo.GetFields())
+}
+
+func convertToMemo(ctx context.Context, codec *payloadCodec, data types.Map) (*commonv1.Memo, diag.Diagnostics) {
+	fields, diags := convertToPayloadFields(ctx, codec, data)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &commonv1.Memo{
+		Fields: fields,
+	}, diags
+}
+
+// convertPayloadFields decodes JSON payloads, as used by memos and headers, into a map.
+// Payloads are first decoded with codec. JSON strings are unquoted, other JSON values are
+// kept as JSON text and entries that are not JSON are skipped with a warning.
+// It returns a null map when there are no fields.
+func convertPayloadFields(ctx context.Context, codec *payloadCodec, fields map[string]*commonv1.Payload) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(fields) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+
+	keys, payloads := sortedPayloads(fields)
+	payloads, err := codec.decode(ctx, payloads)
+	if err != nil {
+		diags.AddError("Failed to decode payloads", err.Error())
+		return basetypes.MapValue{}, diags
+	}
 
-	for key, payload := range memo.Fields {
-		var value string
+	data := make(map[string]string)
 
-		if err := json.Unmarshal(payload.Data, &value); err != nil {
-			diags.AddError(fmt.Sprintf("Failed to unmarshal payload: %s", string(payload.GetData())), err.Error())
+	for i, payload := range payloads {
+		value, _, ok := decodeJSONPayload(payload)
+		if !ok {
+			diags.Append(unreadablePayloadWarning(keys[i], payload))
+			continue
 		}
-		data[key] = value
+		data[keys[i]] = value
 	}
-	result, diags := types.MapValueFrom(ctx, types.StringType, data)
+	if len(data) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	result, mapDiags := types.MapValueFrom(ctx, types.StringType, data)
+	diags.Append(mapDiags...)
 	if diags.HasError() {
 		return basetypes.MapValue{}, diags
 	}
@@ -1032,26 +2573,288 @@ func convertMemo(ctx context.Context, memo *commonv1.Memo) (types.Map, diag.Diag
 	return result, diags
 }
 
-func convertToMemo(ctx context.Context, data types.Map) (*commonv1.Memo, diag.Diagnostics) {
+// convertScheduleMemo splits a schedule memo into string entries and JSON entries.
+// Keys in priorJSON and entries that are not JSON strings go to the JSON map. Entries
+// that are not JSON at all, e.g. binary or protobuf payloads, are skipped with a warning.
+// The string map is empty rather than null so it can be compared with memo_all.
+func convertScheduleMemo(ctx context.Context, codec *payloadCodec, memo *commonv1.Memo, priorJSON types.Map) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	stringValues := make(map[string]attr.Value)
+	jsonValues := make(map[string]attr.Value)
+
+	keys, payloads := sortedPayloads(memo.GetFields())
+	payloads, err := codec.decode(ctx, payloads)
+	if err != nil {
+		diags.AddError("Failed to decode memo", err.Error())
+		return types.MapNull(types.StringType), types.MapNull(jsonType{}), diags
+	}
+
+	for i, payload := range payloads {
+		key := keys[i]
+		value, isString, ok := decodeJSONPayload(payload)
+		if !ok {
+			diags.Append(unreadablePayloadWarning(key, payload))
+			continue
+		}
+
+		if _, managedAsJSON := priorJSON.Elements()[key]; managedAsJSON || !isString {
+			jsonValues[key] = jsonStringValue(string(payload.GetData()))
+			continue
+		}
+		stringValues[key] = types.StringValue(value)
+	}
+
+	memoJSON := types.MapNull(jsonType{})
+	if len(jsonValues) > 0 {
+		memoJSON = types.MapValueMust(jsonType{}, jsonValues)
+	}
+
+	return types.MapValueMust(types.StringType, stringValues), memoJSON, diags
+}
+
+// convertToScheduleMemo builds a schedule memo from string entries and JSON entries.
+func convertToScheduleMemo(ctx context.Context, codec *payloadCodec, memoAll types.Map, memoJSON types.Map) (*commonv1.Memo, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	elements := make(map[string]string)
-	diags.Append(data.ElementsAs(ctx, &elements, false)...)
+	diags.Append(memoAll.ElementsAs(ctx, &elements, false)...)
+	jsonElements := make(map[string]string)
+	if !memoJSON.IsNull() {
+		diags.Append(memoJSON.ElementsAs(ctx, &jsonElements, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
 
-	memo := &commonv1.Memo{
-		Fields: make(map[string]*commonv1.Payload),
+	fields := make(map[string]*commonv1.Payload, len(elements)+len(jsonElements))
+	for k, v := range elements {
+		payload, err := createPayload(v)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to create payload for key: %s", k), err.Error())
+			return nil, diags
+		}
+		fields[k] = payload
+	}
+	for k, v := range jsonElements {
+		fields[k] = createJSONPayload([]byte(v))
+	}
+
+	keys, payloads := sortedPayloads(fields)
+	payloads, err := codec.encode(ctx, payloads)
+	if err != nil {
+		diags.AddError("Failed to encode memo", err.Error())
+		return nil, diags
+	}
+	for i, payload := range payloads {
+		fields[keys[i]] = payload
+	}
+
+	return &commonv1.Memo{Fields: fields}, diags
+}
+
+// decodeJSONPayload returns the value of a JSON payload: the unquoted string for JSON
+// strings, otherwise the JSON text. Payloads without an encoding, as written by earlier
+// provider versions, are treated as JSON. ok is false for payloads that are not JSON.
+func decodeJSONPayload(payload *commonv1.Payload) (value string, isString bool, ok bool) {
+	switch string(payload.GetMetadata()["encoding"]) {
+	case "", "json/plain", "json/protobuf":
+	case "binary/null":
+		return "null", false, true
+	default:
+		return "", false, false
+	}
+
+	data := payload.GetData()
+	if !json.Valid(data) {
+		return "", false, false
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s, true, true
 	}
 
+	return string(data), false, true
+}
+
+// unreadablePayloadWarning reports a payload skipped because it is not JSON.
+func unreadablePayloadWarning(key string, payload *commonv1.Payload) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Unsupported Payload Skipped",
+		fmt.Sprintf("Entry %s has encoding %q, which Terraform cannot represent. It is left unchanged in Temporal and ignored by Terraform.",
+			key, string(payload.GetMetadata()["encoding"])),
+	)
+}
+
+// convertToPayloadFields encodes each map value as a JSON string payload, then with codec.
+func convertToPayloadFields(ctx context.Context, codec *payloadCodec, data types.Map) (map[string]*commonv1.Payload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make(map[string]string)
+	diags.Append(data.ElementsAs(ctx, &elements, false)...)
+
+	fields := make(map[string]*commonv1.Payload)
+
 	for k, v := range elements {
 		payload, err := createPayload(v)
 		if err != nil {
 			diags.AddError(fmt.Sprintf("failed to create payload for key: %s", k), err.Error())
 			return nil, diags
 		}
-		memo.Fields[k] = payload
+		fields[k] = payload
+	}
+
+	keys, payloads := sortedPayloads(fields)
+	payloads, err := codec.encode(ctx, payloads)
+	if err != nil {
+		diags.AddError("Failed to encode payloads", err.Error())
+		return nil, diags
+	}
+	for i, payload := range payloads {
+		fields[keys[i]] = payload
+	}
+
+	return fields, diags
+}
+
+// sortedPayloads returns the keys of fields in sorted order with their payloads,
+// so a whole map can be sent to the codec in one request.
+func sortedPayloads(fields map[string]*commonv1.Payload) ([]string, []*commonv1.Payload) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	payloads := make([]*commonv1.Payload, 0, len(keys))
+	for _, k := range keys {
+		payloads = append(payloads, fields[k])
+	}
+
+	return keys, payloads
+}
+
+// mergeMemo overlays the schedule memo on top of the provider default memo.
+// The result is unknown while the schedule memo is unknown.
+func mergeMemo(ctx context.Context, defaults map[string]string, memo types.Map) (types.Map, diag.Diagnostics) {
+	if memo.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged := make(map[string]string, len(defaults))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+
+	elements := make(map[string]string)
+	diags := memo.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+	for k, v := range elements {
+		merged[k] = v
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, merged)
+}
+
+// stripDefaultMemo derives the schedule memo from the memo stored in Temporal by dropping
+// keys named in the provider default memo, whatever their value, so changing a default does
+// not show up as drift in memo; memo_all shows the stored value instead. Keys present in the
+// prior memo are kept, so values set on the schedule itself are still checked for drift.
+func stripDefaultMemo(ctx context.Context, defaults map[string]string, memoAll types.Map, prior types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if memoAll.IsNull() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	all := make(map[string]string)
+	diags.Append(memoAll.ElementsAs(ctx, &all, false)...)
+	managed := make(map[string]string)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &managed, false)...)
+	}
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	result := make(map[string]string)
+	for k, v := range all {
+		if _, ok := managed[k]; !ok {
+			if _, isDefault := defaults[k]; isDefault {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	if len(result) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, result)
+}
+
+// initialPatchPlanModifier plans initial_patch as configured when the schedule is created
+// and keeps the value used at creation afterwards, as changing it would do nothing. Schedules
+// without a prior initial_patch, e.g. imported ones, plan it as configured; it is only
+// stored, since the patch is only sent at creation.
+type initialPatchPlanModifier struct{}
+
+func (m initialPatchPlanModifier) Description(_ context.Context) string {
+	return "value is kept from the creation of the schedule"
+}
+
+func (m initialPatchPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m initialPatchPlanModifier) PlanModifyObject(_ context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	if req.State.Raw.IsNull() || req.StateValue.IsNull() {
+		resp.PlanValue = req.ConfigValue
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// convertToInitialPatch converts the initial patch model to the patch sent with
+// CreateScheduleRequest, or nil when it is not set.
+func convertToInitialPatch(patchModel *ScheduleInitialPatchModel) (*schedulev1.SchedulePatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if patchModel == nil {
+		return nil, diags
+	}
+
+	patch := &schedulev1.SchedulePatch{}
+	if patchModel.TriggerImmediately != nil {
+		patch.TriggerImmediately = &schedulev1.TriggerImmediatelyRequest{
+			OverlapPolicy: ScheduleOverlapPolicy[patchModel.TriggerImmediately.OverlapPolicy.ValueString()],
+		}
+	}
+	for _, backfill := range patchModel.Backfill {
+		start, err := time.Parse(time.RFC3339, backfill.StartTime.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid Start Time",
+				fmt.Sprintf("Unable to parse backfill start time: %s. Error: %s", backfill.StartTime.ValueString(), err),
+			)
+			return nil, diags
+		}
+		end, err := time.Parse(time.RFC3339, backfill.EndTime.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid End Time",
+				fmt.Sprintf("Unable to parse backfill end time: %s. Error: %s", backfill.EndTime.ValueString(), err),
+			)
+			return nil, diags
+		}
+		patch.BackfillRequest = append(patch.BackfillRequest, &schedulev1.BackfillRequest{
+			StartTime:     timestamppb.New(start),
+			EndTime:       timestamppb.New(end),
+			OverlapPolicy: ScheduleOverlapPolicy[backfill.OverlapPolicy.ValueString()],
+		})
 	}
 
-	return memo, diags
+	return patch, diags
 }
 
 // convertToSchedulePolicy converts a SchedulePolicyModel to a Temporal API SchedulePolicies.
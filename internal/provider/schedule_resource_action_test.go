@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+)
+
+// TestConvertScheduleAction_Roundtrip verifies that every workflow action field reads back
+// exactly as configured, so applying a schedule does not produce a diff on the next plan.
+func TestConvertScheduleAction_Roundtrip(t *testing.T) {
+	ctx := context.Background()
+	model := &ScheduleActionModel{
+		Workflow: &WorkflowActionModel{
+			WorkflowID:       types.StringValue("cleanup"),
+			WorkflowType:     types.StringValue("CleanupWorkflow"),
+			TaskQueue:        types.StringValue("cleanup-queue"),
+			Input:            jsonStringValue(`{"dry_run":false}`),
+			ExecutionTimeout: types.StringValue("1h"),
+			RunTimeout:       types.StringValue("30m"),
+			TaskTimeout:      types.StringValue("10s"),
+			RetryPolicy: &RetryPolicyModel{
+				InitialInterval:        types.StringValue("1s"),
+				BackoffCoefficient:     types.Float64Value(2),
+				MaximumInterval:        types.StringValue("100s"),
+				MaximumAttempts:        types.Int64Value(5),
+				NonRetryableErrorTypes: []types.String{types.StringValue("ValidationError")},
+			},
+			Memo: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner": types.StringValue("team-a"),
+			}),
+			SearchAttributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"CustomerId": types.StringValue("c-42"),
+				"Attempt":    types.StringValue("3"),
+				"Ratio":      types.StringValue("0.5"),
+				"Enabled":    types.StringValue("true"),
+				"DueAt":      types.StringValue("2025-01-01T10:00:00Z"),
+				"Tags":       types.StringValue(`["a","b"]`),
+			}),
+			Header: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"tenant": types.StringValue("acme"),
+			}),
+			Priority: &PriorityModel{
+				PriorityKey:    types.Int64Value(2),
+				FairnessKey:    types.StringValue("tenant-a"),
+				FairnessWeight: types.Float64Value(0.1),
+			},
+		},
+	}
+	saTypes := map[string]enums.IndexedValueType{
+		"CustomerId": enums.INDEXED_VALUE_TYPE_KEYWORD,
+		"Attempt":    enums.INDEXED_VALUE_TYPE_INT,
+		"Ratio":      enums.INDEXED_VALUE_TYPE_DOUBLE,
+		"Enabled":    enums.INDEXED_VALUE_TYPE_BOOL,
+		"DueAt":      enums.INDEXED_VALUE_TYPE_DATETIME,
+		"Tags":       enums.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	}
+
+	action, diags := convertToScheduleAction(ctx, nil, model, saTypes)
+	if diags.HasError() {
+		t.Fatalf("convertToScheduleAction: %v", diags)
+	}
+
+	got, diags := convertScheduleAction(ctx, nil, action, nil)
+	if diags.HasError() {
+		t.Fatalf("convertScheduleAction: %v", diags)
+	}
+	if !reflect.DeepEqual(got, model) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got.Workflow, model.Workflow)
+	}
+}
+
+// TestConvertScheduleAction_DurationForms verifies that configured durations keep their form
+// and sub-second precision on read.
+func TestConvertScheduleAction_DurationForms(t *testing.T) {
+	ctx := context.Background()
+	model := &ScheduleActionModel{
+		Workflow: &WorkflowActionModel{
+			WorkflowID:       types.StringValue("cleanup"),
+			WorkflowType:     types.StringValue("CleanupWorkflow"),
+			TaskQueue:        types.StringValue("cleanup-queue"),
+			Input:            jsonNull(),
+			ExecutionTimeout: types.StringValue("1h30m"),
+			RunTimeout:       types.StringValue("1m30s"),
+			TaskTimeout:      types.StringValue("500ms"),
+			RetryPolicy: &RetryPolicyModel{
+				InitialInterval: types.StringValue("1500ms"),
+				MaximumInterval: types.StringValue("2m"),
+			},
+			Memo:             types.MapNull(types.StringType),
+			SearchAttributes: types.MapNull(types.StringType),
+			Header:           types.MapNull(types.StringType),
+		},
+	}
+
+	action, diags := convertToScheduleAction(ctx, nil, model, nil)
+	if diags.HasError() {
+		t.Fatalf("convertToScheduleAction: %v", diags)
+	}
+
+	got, diags := convertScheduleAction(ctx, nil, action, model)
+	if diags.HasError() {
+		t.Fatalf("convertScheduleAction: %v", diags)
+	}
+	if !reflect.DeepEqual(got, model) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got.Workflow, model.Workflow)
+	}
+
+	// Without a prior form, durations read back in their canonical form.
+	got, _ = convertScheduleAction(ctx, nil, action, nil)
+	workflow := got.Workflow
+	for _, c := range []struct{ got, want string }{
+		{workflow.ExecutionTimeout.ValueString(), "90m"},
+		{workflow.RunTimeout.ValueString(), "90s"},
+		{workflow.TaskTimeout.ValueString(), "500ms"},
+		{workflow.RetryPolicy.InitialInterval.ValueString(), "1.5s"},
+		{workflow.RetryPolicy.MaximumInterval.ValueString(), "2m"},
+	} {
+		if c.got != c.want {
+			t.Errorf("got %q, want %q", c.got, c.want)
+		}
+	}
+}
+
+// TestConvertScheduleAction_UnsetFieldsStayNull verifies that optional workflow fields
+// absent on the server are read as null rather than empty values.
+func TestConvertScheduleAction_UnsetFieldsStayNull(t *testing.T) {
+	ctx := context.Background()
+	model := &ScheduleActionModel{
+		Workflow: &WorkflowActionModel{
+			WorkflowID:   types.StringValue("cleanup"),
+			WorkflowType: types.StringValue("CleanupWorkflow"),
+			TaskQueue:    types.StringValue("cleanup-queue"),
+			RetryPolicy: &RetryPolicyModel{
+				MaximumAttempts: types.Int64Value(3),
+			},
+		},
+	}
+
+	action, diags := convertToScheduleAction(ctx, nil, model, nil)
+	if diags.HasError() {
+		t.Fatalf("convertToScheduleAction: %v", diags)
+	}
+
+	got, diags := convertScheduleAction(ctx, nil, action, nil)
+	if diags.HasError() {
+		t.Fatalf("convertScheduleAction: %v", diags)
+	}
+	workflow := got.Workflow
+	if !workflow.Memo.IsNull() || !workflow.SearchAttributes.IsNull() || !workflow.Header.IsNull() {
+		t.Errorf("expected null memo, search attributes and header, got %v %v %v",
+			workflow.Memo, workflow.SearchAttributes, workflow.Header)
+	}
+	if workflow.Priority != nil {
+		t.Errorf("expected nil priority, got %+v", workflow.Priority)
+	}
+	if !workflow.RetryPolicy.InitialInterval.IsNull() || !workflow.RetryPolicy.BackoffCoefficient.IsNull() {
+		t.Errorf("expected unset retry policy fields to be null, got %+v", workflow.RetryPolicy)
+	}
+	if workflow.RetryPolicy.NonRetryableErrorTypes != nil {
+		t.Errorf("expected null non_retryable_error_types, got %v", workflow.RetryPolicy.NonRetryableErrorTypes)
+	}
+}
+
+// TestConvertToSearchAttributes_TypedPayloads verifies that values are encoded according to
+// the registered type and that unregistered attributes and invalid values are rejected.
+func TestConvertToSearchAttributes_TypedPayloads(t *testing.T) {
+	ctx := context.Background()
+	saTypes := map[string]enums.IndexedValueType{
+		"Attempt": enums.INDEXED_VALUE_TYPE_INT,
+	}
+
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Attempt": types.StringValue("3"),
+	})
+	searchAttributes, diags := convertToSearchAttributes(ctx, values, saTypes)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	payload := searchAttributes.GetIndexedFields()["Attempt"]
+	if string(payload.GetData()) != "3" || string(payload.GetMetadata()["type"]) != "Int" {
+		t.Errorf("unexpected payload: %v", payload)
+	}
+
+	for name, value := range map[string]string{"Attempt": "three", "Unknown": "x"} {
+		values := types.MapValueMust(types.StringType, map[string]attr.Value{
+			name: types.StringValue(value),
+		})
+		if _, diags := convertToSearchAttributes(ctx, values, saTypes); !diags.HasError() {
+			t.Errorf("expected an error for %s=%q", name, value)
+		}
+	}
+}
+
+// TestConvertSearchAttributes_Untyped verifies that payloads without type metadata,
+// e.g. written by other clients, are still decoded.
+func TestConvertSearchAttributes_Untyped(t *testing.T) {
+	got, diags := convertSearchAttributes(&commonv1.SearchAttributes{
+		IndexedFields: map[string]*commonv1.Payload{
+			"CustomerId": {Data: []byte(`"c-42"`)},
+			"Attempt":    {Data: []byte(`12345678901234`)},
+		},
+	}, types.MapNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	want := map[string]string{"CustomerId": "c-42", "Attempt": "12345678901234"}
+	elements := memoElements(t, got)
+	if !reflect.DeepEqual(elements, want) {
+		t.Errorf("got %v, want %v", elements, want)
+	}
+}
+
+// TestConvertScheduleAction_Inputs verifies that each workflow argument becomes its own
+// payload and that a single-element inputs list keeps its form on read.
+func TestConvertScheduleAction_Inputs(t *testing.T) {
+	ctx := context.Background()
+	for _, inputs := range [][]jsonValue{
+		{jsonStringValue(`"a"`), jsonStringValue(`{"b": 1}`)},
+		{jsonStringValue(`[1, 2]`)},
+	} {
+		model := &ScheduleActionModel{
+			Workflow: &WorkflowActionModel{
+				WorkflowID:   types.StringValue("cleanup"),
+				WorkflowType: types.StringValue("CleanupWorkflow"),
+				TaskQueue:    types.StringValue("cleanup-queue"),
+				Input:        jsonNull(),
+				Inputs:       inputs,
+				// Absent maps read back as typed nulls.
+				Memo:             types.MapNull(types.StringType),
+				SearchAttributes: types.MapNull(types.StringType),
+				Header:           types.MapNull(types.StringType),
+			},
+		}
+
+		action, diags := convertToScheduleAction(ctx, nil, model, nil)
+		if diags.HasError() {
+			t.Fatalf("convertToScheduleAction: %v", diags)
+		}
+		if got := len(action.GetStartWorkflow().GetInput().GetPayloads()); got != len(inputs) {
+			t.Fatalf("expected %d payloads, got %d", len(inputs), got)
+		}
+
+		got, diags := convertScheduleAction(ctx, nil, action, nil)
+		if diags.HasError() {
+			t.Fatalf("convertScheduleAction: %v", diags)
+		}
+		keepInputsForm(model, got)
+		if !reflect.DeepEqual(got, model) {
+			t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got.Workflow, model.Workflow)
+		}
+	}
+}
+
+// TestScheduleSearchAttributes_SystemAttributes verifies that search attributes the server
+// sets on every schedule are only read back when managed in Terraform.
+func TestScheduleSearchAttributes_SystemAttributes(t *testing.T) {
+	searchAttributes := &commonv1.SearchAttributes{
+		IndexedFields: map[string]*commonv1.Payload{
+			"CustomerId":             {Data: []byte(`"c-42"`)},
+			"TemporalSchedulePaused": {Data: []byte(`false`)},
+		},
+	}
+
+	got, _ := convertSearchAttributes(scheduleSearchAttributes(searchAttributes, types.MapNull(types.StringType)), types.MapNull(types.StringType))
+	if want := map[string]string{"CustomerId": "c-42"}; !reflect.DeepEqual(memoElements(t, got), want) {
+		t.Errorf("got %v, want %v", memoElements(t, got), want)
+	}
+
+	managed := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"TemporalSchedulePaused": types.StringValue("false"),
+	})
+	got, _ = convertSearchAttributes(scheduleSearchAttributes(searchAttributes, managed), managed)
+	if len(got.Elements()) != 2 {
+		t.Errorf("expected the managed system attribute to be kept, got %v", got)
+	}
+}
+
+// TestConvertSearchAttributes_KeepsEquivalentValues verifies that configured values the
+// server returns in another form keep their configured form.
+func TestConvertSearchAttributes_KeepsEquivalentValues(t *testing.T) {
+	saTypes := map[string]enums.IndexedValueType{
+		"Ratio":     enums.INDEXED_VALUE_TYPE_DOUBLE,
+		"Enabled":   enums.INDEXED_VALUE_TYPE_BOOL,
+		"StartedAt": enums.INDEXED_VALUE_TYPE_DATETIME,
+		"Tags":      enums.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		"Attempt":   enums.INDEXED_VALUE_TYPE_INT,
+	}
+	configured := map[string]string{
+		"Ratio":     "1.0",
+		"Enabled":   "True",
+		"StartedAt": "2025-01-01T02:00:00+02:00",
+		"Tags":      `["a", "b"]`,
+		"Attempt":   "3",
+	}
+	returned := map[string]string{
+		"Ratio":     "1",
+		"Enabled":   "true",
+		"StartedAt": "2025-01-01T00:00:00Z",
+		"Tags":      `["a","b"]`,
+		"Attempt":   "4",
+	}
+
+	prior := make(map[string]attr.Value, len(configured))
+	fields := make(map[string]*commonv1.Payload, len(returned))
+	for name, value := range configured {
+		prior[name] = types.StringValue(value)
+		payload, err := encodeSearchAttributeValue(returned[name], saTypes[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields[name] = payload
+	}
+
+	got, diags := convertSearchAttributes(&commonv1.SearchAttributes{IndexedFields: fields}, types.MapValueMust(types.StringType, prior))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	want := map[string]string{
+		"Ratio":     "1.0",
+		"Enabled":   "True",
+		"StartedAt": "2025-01-01T02:00:00+02:00",
+		"Tags":      `["a", "b"]`,
+		"Attempt":   "4",
+	}
+	if elements := memoElements(t, got); !reflect.DeepEqual(elements, want) {
+		t.Errorf("got %v, want %v", elements, want)
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.temporal.io/api/serviceerror"
+)
+
+// privateKeys is a private state holding keys in memory.
+type privateKeys map[string][]byte
+
+func (p privateKeys) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateKeys) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func storedConflictToken(t *testing.T, token []byte) privateKeys {
+	t.Helper()
+	value, err := json.Marshal(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privateKeys{conflictTokenKey: value}
+}
+
+func TestCheckConflictToken(t *testing.T) {
+	ctx := context.Background()
+	current := []byte{0, 0, 0, 0, 0, 0, 0, 2}
+
+	cases := []struct {
+		name      string
+		private   privateKeys
+		wantError bool
+	}{
+		{name: "no token", private: privateKeys{}},
+		{name: "token cleared by update", private: storedConflictToken(t, nil)},
+		{name: "unchanged", private: storedConflictToken(t, current)},
+		{name: "changed outside terraform", private: storedConflictToken(t, []byte{0, 0, 0, 0, 0, 0, 0, 1}), wantError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := checkConflictToken(ctx, c.private, "test-schedule", current)
+			if diags.HasError() != c.wantError {
+				t.Fatalf("expected error %v, got %v", c.wantError, diags)
+			}
+			if c.wantError && diags[0].Summary() != "Schedule Changed Outside Terraform" {
+				t.Errorf("unexpected diagnostic %q", diags[0].Summary())
+			}
+		})
+	}
+}
+
+func TestIsConflictTokenMismatch(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{serviceerror.NewFailedPrecondition("mismatched conflict token"), true},
+		{serviceerror.NewAborted("schedule update conflict"), true},
+		{serviceerror.NewFailedPrecondition("namespace is not active"), false},
+		{serviceerror.NewInvalidArgument("conflict token is invalid"), false},
+	}
+
+	for _, c := range cases {
+		if got := isConflictTokenMismatch(updateScheduleError(t, c.err)); got != c.want {
+			t.Errorf("isConflictTokenMismatch(%v): got %v, want %v", c.err, got, c.want)
+		}
+	}
+	if isConflictTokenMismatch(errors.New("conflict")) {
+		t.Error("expected an error that is not a service error not to match")
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	taskqueuev1 "go.temporal.io/api/taskqueue/v1"
+	workflowv1 "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// testDescribedSchedule returns the description of a schedule created outside Terraform,
+// without policies and with a memo and search attributes.
+func testDescribedSchedule(t *testing.T) *workflowservice.DescribeScheduleResponse {
+	t.Helper()
+	owner, err := createPayload("platform-team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := encodeSearchAttributeValue("prod", enums.INDEXED_VALUE_TYPE_KEYWORD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paused, err := encodeSearchAttributeValue("true", enums.INDEXED_VALUE_TYPE_BOOL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &workflowservice.DescribeScheduleResponse{
+		Schedule: &schedulev1.Schedule{
+			Spec: &schedulev1.ScheduleSpec{
+				Interval: []*schedulev1.IntervalSpec{{Interval: durationpb.New(48 * time.Hour)}},
+				StructuredCalendar: []*schedulev1.StructuredCalendarSpec{{
+					Second: []*schedulev1.Range{{Start: 0, End: 0, Step: 1}},
+					Minute: []*schedulev1.Range{{Start: 30, End: 30, Step: 1}},
+					Hour:   []*schedulev1.Range{{Start: 9, End: 9, Step: 1}},
+				}},
+				TimezoneName: "Europe/Berlin",
+			},
+			Action: &schedulev1.ScheduleAction{
+				Action: &schedulev1.ScheduleAction_StartWorkflow{
+					StartWorkflow: &workflowv1.NewWorkflowExecutionInfo{
+						WorkflowId:   "report",
+						WorkflowType: &commonv1.WorkflowType{Name: "ReportWorkflow"},
+						TaskQueue:    &taskqueuev1.TaskQueue{Name: "reports"},
+						Input:        &commonv1.Payloads{Payloads: []*commonv1.Payload{createJSONPayload([]byte(`{"days":7}`))}},
+					},
+				},
+			},
+			State: &schedulev1.ScheduleState{Paused: true, Notes: "paused in the UI"},
+		},
+		Memo: &commonv1.Memo{Fields: map[string]*commonv1.Payload{
+			"owner":  owner,
+			"limits": createJSONPayload([]byte(`{"max":3}`)),
+		}},
+		SearchAttributes: &commonv1.SearchAttributes{IndexedFields: map[string]*commonv1.Payload{
+			"Environment":            env,
+			"TemporalSchedulePaused": paused,
+		}},
+	}
+}
+
+func TestScheduleImport_CompleteModel(t *testing.T) {
+	ctx := context.Background()
+	r := &ScheduleResource{namespace: "default"}
+
+	data := importedScheduleModel("default", "report")
+	diags := r.refreshFromDescription(ctx, testDescribedSchedule(t), &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	// The imported model must be a valid state.
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if data.Spec == nil || len(data.Spec.Intervals) != 1 || len(data.Spec.CalendarItems) != 1 {
+		t.Fatalf("expected the spec to be imported, got %+v", data.Spec)
+	}
+	if got := data.Spec.Intervals[0].Every.ValueString(); got != "48h" {
+		t.Errorf("expected interval 48h, got %s", got)
+	}
+	if got := data.Spec.TimeZone.ValueString(); got != "Europe/Berlin" {
+		t.Errorf("expected time zone Europe/Berlin, got %s", got)
+	}
+	if data.Action == nil || data.Action.Workflow == nil || data.Action.Workflow.WorkflowType.ValueString() != "ReportWorkflow" {
+		t.Fatalf("expected the action to be imported, got %+v", data.Action)
+	}
+	if got := data.Action.Workflow.Input.ValueString(); got != `{"days":7}` {
+		t.Errorf("expected the workflow input to be imported, got %s", got)
+	}
+	if data.State == nil || !data.State.Paused.ValueBool() || data.State.Notes.ValueString() != "paused in the UI" {
+		t.Errorf("expected the paused state to be imported, got %+v", data.State)
+	}
+	if !data.ManageState.ValueBool() {
+		t.Error("expected the imported state to be managed")
+	}
+	if data.Policy == nil || data.Policy.Overlap.ValueString() != "Skip" || data.Policy.CatchupWindow.ValueString() != "0s" {
+		t.Errorf("expected default policies, got %+v", data.Policy)
+	}
+	if !data.Memo.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("platform-team")})) {
+		t.Errorf("expected the string memo entry in memo, got %v", data.Memo)
+	}
+	if _, ok := data.MemoJSON.Elements()["limits"]; !ok {
+		t.Errorf("expected the JSON memo entry in memo_json, got %v", data.MemoJSON)
+	}
+	if !data.SearchAttributes.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"Environment": types.StringValue("prod")})) {
+		t.Errorf("expected only the custom search attribute, got %v", data.SearchAttributes)
+	}
+	if data.InitialPatch != nil {
+		t.Error("expected no initial patch")
+	}
+}
+
+// TestScheduleImport_RoundTrip verifies that the imported spec and policies are sent back
+// as an equivalent schedule, so applying the generated configuration changes nothing.
+func TestScheduleImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	r := &ScheduleResource{namespace: "default"}
+	described := testDescribedSchedule(t)
+
+	data := importedScheduleModel("default", "report")
+	if diags := r.refreshFromDescription(ctx, described, &data); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	spec, diags := convertToScheduleSpec(data.Spec)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	compiled, err := compileScheduleSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := compileScheduleSpec(described.GetSchedule().GetSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, wantTimes := mustNextTimes(t, compiled, "", after, 20), mustNextTimes(t, want, "", after, 20)
+	for i := range wantTimes {
+		if i >= len(got) || !got[i].nominal.Equal(wantTimes[i].nominal) {
+			t.Fatalf("action times differ at %d: got %v, want %v", i, got, wantTimes)
+		}
+	}
+
+	policies, diags := convertToSchedulePolicy(data.Policy)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if policies.GetOverlapPolicy() != enums.SCHEDULE_OVERLAP_POLICY_SKIP || policies.GetCatchupWindow().AsDuration() != 0 {
+		t.Errorf("unexpected policies %v", policies)
+	}
+}
+
+// TestScheduleImport_StateAndPolicies verifies that the state and policies of an imported
+// schedule are read in their configured form.
+func TestScheduleImport_StateAndPolicies(t *testing.T) {
+	r := &ScheduleResource{namespace: "default"}
+	data := importedScheduleModel("default", "id")
+	diags := r.refreshFromDescription(context.Background(), &workflowservice.DescribeScheduleResponse{
+		Schedule: &schedulev1.Schedule{
+			State: &schedulev1.ScheduleState{Paused: true, Notes: "paused"},
+			Policies: &schedulev1.SchedulePolicies{
+				OverlapPolicy:  enums.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+				CatchupWindow:  durationpb.New(365 * 24 * time.Hour),
+				PauseOnFailure: true,
+			},
+		},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if data.State == nil || !data.State.Paused.ValueBool() || data.State.Notes.ValueString() != "paused" {
+		t.Errorf("expected the state to be converted, got %+v", data.State)
+	}
+	want := &SchedulePolicyModel{
+		Overlap:        types.StringValue("BufferOne"),
+		CatchupWindow:  types.StringValue("8760h"),
+		PauseOnFailure: types.BoolValue(true),
+	}
+	if data.Policy == nil || *data.Policy != *want {
+		t.Errorf("got policy %+v, want %+v", data.Policy, want)
+	}
+	if !data.Memo.IsNull() || !data.MemoJSON.IsNull() || !data.SearchAttributes.IsNull() {
+		t.Error("expected memo and search attributes to be null without any on the schedule")
+	}
+}
+
+// TestConvertFromTemporalSchedule_MatchesImport verifies that ConvertFromTemporalSchedule
+// reads a schedule into the same model as an import of that schedule.
+func TestConvertFromTemporalSchedule_MatchesImport(t *testing.T) {
+	schedule := testDescribedSchedule(t).GetSchedule()
+	model, err := ConvertFromTemporalSchedule("id", "default", schedule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := &ScheduleResource{namespace: "default"}
+	data := importedScheduleModel("default", "id")
+	if diags := r.refreshFromDescription(context.Background(), &workflowservice.DescribeScheduleResponse{Schedule: schedule}, &data); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !reflect.DeepEqual(*model, data) {
+		t.Errorf("got %+v, want %+v", *model, data)
+	}
+}
+
+func TestFormatDurationCanonical_Parses(t *testing.T) {
+	for _, d := range []time.Duration{0, 500 * time.Millisecond, time.Second, 1500 * time.Millisecond, 90 * time.Second, time.Hour, 48 * time.Hour, 365 * 24 * time.Hour} {
+		s := formatDurationCanonical(durationpb.New(d))
+		parsed, err := time.ParseDuration(s)
+		if err != nil || parsed != d {
+			t.Errorf("%s: formatted as %q, which parses as %s (%v)", d, s, parsed, err)
+		}
+	}
+}
+
+// TestDefaultCalendarItem verifies that defaultCalendarItem matches the schema defaults, so
+// imported calendar expressions read back the values planned for omitted fields.
+func TestDefaultCalendarItem(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewScheduleResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	calendars := schemaResp.Schema.Attributes["spec"].(schema.SingleNestedAttribute).Attributes["calendar_items"].(schema.ListNestedAttribute)
+	want := map[string]types.String{
+		"year":         defaultCalendarItem.Year,
+		"month":        defaultCalendarItem.Month,
+		"day_of_month": defaultCalendarItem.DayOfMonth,
+		"day_of_week":  defaultCalendarItem.DayOfWeek,
+		"hour":         defaultCalendarItem.Hour,
+		"minute":       defaultCalendarItem.Minute,
+		"second":       defaultCalendarItem.Second,
+	}
+	for name, value := range want {
+		attribute := calendars.NestedObject.Attributes[name].(schema.StringAttribute)
+		var resp defaults.StringResponse
+		attribute.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		if !resp.PlanValue.Equal(value) {
+			t.Errorf("%s: default is %s, defaultCalendarItem has %s", name, resp.PlanValue, value)
+		}
+	}
+
+	// A calendar of only default fields reads back as the defaults.
+	items := convertStructuredCalendars(convertToStructuredCalendars([]StructuredCalendarModel{{Comment: types.StringValue("")}}), nil)
+	if len(items) != 1 || items[0] != (CalendarModel{
+		Year: defaultCalendarItem.Year, Month: defaultCalendarItem.Month, DayOfMonth: defaultCalendarItem.DayOfMonth,
+		DayOfWeek: defaultCalendarItem.DayOfWeek, Hour: defaultCalendarItem.Hour, Minute: defaultCalendarItem.Minute,
+		Second: defaultCalendarItem.Second, Comment: types.StringValue(""),
+	}) {
+		t.Errorf("unexpected calendar %+v", items)
+	}
+}
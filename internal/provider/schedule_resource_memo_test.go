@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+func memoElements(t *testing.T, m types.Map) map[string]string {
+	t.Helper()
+	out := make(map[string]string)
+	if diags := m.ElementsAs(context.Background(), &out, false); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	return out
+}
+
+// TestMergeMemo_ScheduleMemoWins verifies that schedule memo entries override
+// provider default memo entries with the same key.
+func TestMergeMemo_ScheduleMemoWins(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform", "stack": "payments"}
+	memo := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"stack": types.StringValue("billing"),
+		"owner": types.StringValue("team-a"),
+	})
+
+	merged, diags := mergeMemo(ctx, defaults, memo)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got := memoElements(t, merged)
+	want := map[string]string{"managed_by": "terraform", "stack": "billing", "owner": "team-a"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+// TestMergeMemo_NullMemo verifies that a schedule without memo still receives
+// the provider defaults and that unknown memos stay unknown during planning.
+func TestMergeMemo_NullMemo(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform"}
+
+	merged, diags := mergeMemo(ctx, defaults, types.MapNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got := memoElements(t, merged); got["managed_by"] != "terraform" || len(got) != 1 {
+		t.Errorf("unexpected merged memo: %v", got)
+	}
+
+	merged, _ = mergeMemo(ctx, defaults, types.MapUnknown(types.StringType))
+	if !merged.IsUnknown() {
+		t.Error("expected unknown memo_all for an unknown memo")
+	}
+}
+
+// TestStripDefaultMemo_DefaultsDoNotDrift verifies that entries coming only from
+// the provider default memo are not reported back in the schedule memo.
+func TestStripDefaultMemo_DefaultsDoNotDrift(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform"}
+	all := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"managed_by": types.StringValue("terraform"),
+	})
+
+	memo, diags := stripDefaultMemo(ctx, defaults, all, types.MapNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !memo.IsNull() {
+		t.Errorf("expected null memo when only defaults are present, got %v", memo)
+	}
+}
+
+// TestStripDefaultMemo_KeepsManagedKeys verifies that keys set on the schedule
+// itself and keys added outside Terraform remain visible as drift.
+func TestStripDefaultMemo_KeepsManagedKeys(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform", "stack": "payments"}
+	all := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"managed_by": types.StringValue("terraform"),
+		"stack":      types.StringValue("payments"),
+		"owner":      types.StringValue("ops"),
+	})
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"stack": types.StringValue("payments"),
+	})
+
+	memo, diags := stripDefaultMemo(ctx, defaults, all, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got := memoElements(t, memo)
+	if _, ok := got["managed_by"]; ok {
+		t.Error("default-only key must be stripped from memo")
+	}
+	if got["stack"] != "payments" {
+		t.Errorf("managed key must be kept, got %v", got)
+	}
+	if got["owner"] != "ops" {
+		t.Errorf("key added outside Terraform must be kept, got %v", got)
+	}
+}
+
+// TestStripDefaultMemo_ChangedDefaultDoesNotDrift verifies that a default key still
+// holding the previous default value on the server is not reported in memo.
+func TestStripDefaultMemo_ChangedDefaultDoesNotDrift(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform-v2"}
+	all := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"managed_by": types.StringValue("terraform"),
+		"owner":      types.StringValue("team-a"),
+	})
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner": types.StringValue("team-a"),
+	})
+
+	memo, diags := stripDefaultMemo(ctx, defaults, all, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got := memoElements(t, memo)
+	if _, ok := got["managed_by"]; ok {
+		t.Errorf("default key with a previous default value must be stripped, got %v", got)
+	}
+	if got["owner"] != "team-a" || len(got) != 1 {
+		t.Errorf("unexpected memo: %v", got)
+	}
+}
+
+// TestConvertScheduleMemo_MixedEntries verifies that string entries, JSON entries and
+// entries Terraform cannot represent are split without failing the read.
+func TestConvertScheduleMemo_MixedEntries(t *testing.T) {
+	ctx := context.Background()
+	memo := &commonv1.Memo{Fields: map[string]*commonv1.Payload{
+		"owner":   {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"team-a"`)},
+		"legacy":  {Data: []byte(`"no-encoding"`)},
+		"retries": {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`3`)},
+		"config":  {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`{"a": [1, 2]}`)},
+		"managed": {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"as-json"`)},
+		"blob":    {Metadata: map[string][]byte{"encoding": []byte("binary/plain")}, Data: []byte{0xff, 0x00}},
+		"proto":   {Metadata: map[string][]byte{"encoding": []byte("binary/protobuf")}, Data: []byte{0x0a, 0x01}},
+	}}
+	priorJSON := types.MapValueMust(jsonType{}, map[string]attr.Value{
+		"managed": jsonStringValue(`"as-json"`),
+	})
+
+	memoAll, memoJSON, diags := convertScheduleMemo(ctx, nil, memo, priorJSON)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 2 {
+		t.Errorf("expected a warning for each binary entry, got %v", diags)
+	}
+
+	wantStrings := map[string]string{"owner": "team-a", "legacy": "no-encoding"}
+	if got := memoElements(t, memoAll); !reflect.DeepEqual(got, wantStrings) {
+		t.Errorf("memo_all: got %v, want %v", got, wantStrings)
+	}
+	wantJSON := map[string]string{"retries": `3`, "config": `{"a": [1, 2]}`, "managed": `"as-json"`}
+	if got := memoElements(t, memoJSON); !reflect.DeepEqual(got, wantJSON) {
+		t.Errorf("memo_json: got %v, want %v", got, wantJSON)
+	}
+}
+
+// TestConvertToScheduleMemo_EncodingMetadata verifies that every memo payload written by
+// the provider carries the json/plain encoding.
+func TestConvertToScheduleMemo_EncodingMetadata(t *testing.T) {
+	ctx := context.Background()
+	memoAll := types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("team-a")})
+	memoJSON := types.MapValueMust(jsonType{}, map[string]attr.Value{"retries": jsonStringValue(`3`)})
+
+	memo, diags := convertToScheduleMemo(ctx, nil, memoAll, memoJSON)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := map[string]string{"owner": `"team-a"`, "retries": `3`}
+	for key, data := range want {
+		payload := memo.GetFields()[key]
+		if string(payload.GetData()) != data || string(payload.GetMetadata()["encoding"]) != "json/plain" {
+			t.Errorf("%s: unexpected payload %v", key, payload)
+		}
+	}
+}
+
+// TestMemoFieldsEqual verifies the comparison used to detect servers that ignore memo updates.
+func TestMemoFieldsEqual(t *testing.T) {
+	memo := func(data string) *commonv1.Memo {
+		return &commonv1.Memo{Fields: map[string]*commonv1.Payload{
+			"owner": {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(data)},
+		}}
+	}
+
+	if !memoFieldsEqual(memo(`"team-a"`), memo(`"team-a"`)) {
+		t.Error("expected equal memos to match")
+	}
+	if memoFieldsEqual(memo(`"team-a"`), memo(`"team-b"`)) {
+		t.Error("expected a changed value not to match")
+	}
+	if memoFieldsEqual(nil, memo(`"team-a"`)) {
+		t.Error("expected a missing memo not to match")
+	}
+}
+
+// TestIsMemoUpdateUnsupported verifies which UpdateSchedule errors trigger the replacement fallback.
+func TestIsMemoUpdateUnsupported(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{serviceerror.NewUnimplemented("memo update is not supported"), true},
+		{serviceerror.NewInvalidArgument("memo updates are disabled"), true},
+		{serviceerror.NewInvalidArgument("invalid cron string"), false},
+		{serviceerror.NewUnavailable("try again"), false},
+	}
+
+	for _, c := range cases {
+		if got := isMemoUpdateUnsupported(updateScheduleError(t, c.err)); got != c.want {
+			t.Errorf("isMemoUpdateUnsupported(%v): got %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+// TestCheckMemoApplied verifies that a memo the server has not applied in time only warns,
+// leaving memo changes in place, and that an applied memo clears an earlier record of memo
+// updates being unsupported.
+func TestCheckMemoApplied(t *testing.T) {
+	ctx := context.Background()
+	memo := func(data string) *commonv1.Memo {
+		return &commonv1.Memo{Fields: map[string]*commonv1.Payload{
+			"owner": {Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(data)},
+		}}
+	}
+
+	for _, c := range []struct {
+		name        string
+		stored      *commonv1.Memo
+		private     privateKeys
+		wantWarning bool
+	}{
+		{"not applied in time", memo(`"team-a"`), privateKeys{}, true},
+		{"applied", memo(`"team-b"`), privateKeys{}, false},
+		{"applied after unsupported", memo(`"team-b"`), privateKeys{memoUpdateUnsupportedKey: []byte("true")}, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := &ScheduleResource{client: startFakeWorkflowService(t, &fakeWorkflowService{memo: c.stored})}
+
+			diags := r.checkMemoApplied(ctx, c.private, "default", "test-schedule", memo(`"team-b"`))
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+			if c.private[memoUpdateUnsupportedKey] != nil {
+				t.Error("expected memo updates not to be recorded as unsupported")
+			}
+			if got := diags.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("expected warning %v, got %v", c.wantWarning, diags)
+			}
+		})
+	}
+}
@@ -1,17 +1,19 @@
 package provider
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	schedulev1 "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // TestConvertScheduleSpec_NilSpec verifies that convertScheduleSpec returns nil
 // without panicking when called with a nil spec.
 func TestConvertScheduleSpec_NilSpec(t *testing.T) {
-	model := convertScheduleSpec(nil)
+	model := convertScheduleSpec(nil, nil)
 	if model != nil {
 		t.Errorf("expected nil for nil input, got %+v", model)
 	}
@@ -21,7 +23,7 @@ func TestConvertScheduleSpec_NilSpec(t *testing.T) {
 // in a null Terraform value rather than a panic.
 func TestConvertScheduleSpec_NilJitter(t *testing.T) {
 	spec := &schedulev1.ScheduleSpec{TimezoneName: "UTC"}
-	model := convertScheduleSpec(spec)
+	model := convertScheduleSpec(spec, nil)
 	if model == nil {
 		t.Fatal("expected non-nil model")
 	}
@@ -38,7 +40,7 @@ func TestConvertScheduleSpec_JitterRoundtrip(t *testing.T) {
 		Jitter: durationpb.New(5 * time.Minute),
 	}
 
-	model := convertScheduleSpec(spec)
+	model := convertScheduleSpec(spec, nil)
 	if model == nil {
 		t.Fatal("expected non-nil ScheduleSpecModel")
 	}
@@ -74,3 +76,35 @@ func TestConvertFromTemporalSchedule_NilSpecAndAction(t *testing.T) {
 		t.Error("expected Action to be nil when Schedule.Action is nil")
 	}
 }
+
+// TestRefreshFromDescription_NilSchedule verifies that a description without a schedule
+// does not panic and leaves the spec and action unset.
+func TestRefreshFromDescription_NilSchedule(t *testing.T) {
+	r := &ScheduleResource{namespace: "default"}
+	data := importedScheduleModel("ns", "id")
+	if diags := r.refreshFromDescription(context.Background(), &workflowservice.DescribeScheduleResponse{}, &data); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if data.Spec != nil || data.Action != nil {
+		t.Errorf("expected no spec and action, got %+v", data)
+	}
+}
+
+// TestRefreshFromDescription_NilSpecAndAction verifies that a schedule with nil Spec
+// and Action fields does not panic and leaves the model fields nil.
+func TestRefreshFromDescription_NilSpecAndAction(t *testing.T) {
+	r := &ScheduleResource{namespace: "default"}
+	data := importedScheduleModel("default", "id")
+	diags := r.refreshFromDescription(context.Background(), &workflowservice.DescribeScheduleResponse{
+		Schedule: &schedulev1.Schedule{},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if data.Spec != nil {
+		t.Error("expected Spec to be nil when Schedule.Spec is nil")
+	}
+	if data.Action != nil {
+		t.Error("expected Action to be nil when Schedule.Action is nil")
+	}
+}
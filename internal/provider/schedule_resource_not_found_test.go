@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// testScheduleResourceState returns the state of a minimal schedule.
+func testScheduleResourceState(t *testing.T) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewScheduleResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &ScheduleResourceModel{
+		Namespace:        types.StringValue("default"),
+		ScheduleID:       types.StringValue("test-schedule"),
+		Memo:             types.MapNull(types.StringType),
+		MemoJSON:         types.MapNull(jsonType{}),
+		MemoAll:          types.MapValueMust(types.StringType, nil),
+		SearchAttributes: types.MapNull(types.StringType),
+		ManageState:      types.BoolValue(true),
+		Info:             types.ObjectNull(scheduleInfoAttrTypes),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	return state
+}
+
+func TestScheduleResourceRead_NotFound(t *testing.T) {
+	ctx := context.Background()
+	conn := startFakeWorkflowService(t, &fakeWorkflowService{
+		describeErr: serviceerror.NewNotFound("schedule not found"),
+	})
+	r := &ScheduleResource{client: conn, namespace: "default"}
+
+	state := testScheduleResourceState(t)
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the schedule to be removed from state, got %v", resp.State.Raw)
+	}
+}
+
+func TestScheduleResourceRead_Error(t *testing.T) {
+	ctx := context.Background()
+	conn := startFakeWorkflowService(t, &fakeWorkflowService{
+		describeErr: serviceerror.NewUnavailable("server unavailable"),
+	})
+	r := &ScheduleResource{client: conn, namespace: "default"}
+
+	state := testScheduleResourceState(t)
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Error Reading Schedule" {
+		t.Fatalf("expected a read error, got %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("expected the schedule to stay in state")
+	}
+}
+
+func TestScheduleResourceDelete(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name      string
+		err       error
+		wantError bool
+	}{
+		{name: "deleted"},
+		{name: "not found", err: serviceerror.NewNotFound("schedule not found")},
+		{name: "unavailable", err: serviceerror.NewUnavailable("server unavailable"), wantError: true},
+	}
+
+	for _, c := range cases {
+		conn := startFakeWorkflowService(t, &fakeWorkflowService{deleteErr: c.err})
+		r := &ScheduleResource{client: conn, namespace: "default"}
+
+		var resp resource.DeleteResponse
+		r.Delete(ctx, resource.DeleteRequest{State: testScheduleResourceState(t)}, &resp)
+		if resp.Diagnostics.HasError() != c.wantError {
+			t.Errorf("%s: unexpected diags: %v", c.name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestIsScheduleNotFound(t *testing.T) {
+	conn := startFakeWorkflowService(t, &fakeWorkflowService{
+		describeErr: serviceerror.NewNotFound("schedule not found"),
+	})
+	_, err := workflowservice.NewWorkflowServiceClient(conn).DescribeSchedule(context.Background(), &workflowservice.DescribeScheduleRequest{})
+
+	if !isScheduleNotFound(err) {
+		t.Errorf("expected a NotFound service error, got %T", toServiceError(err))
+	}
+	if isScheduleNotFound(nil) {
+		t.Error("expected no error to not be NotFound")
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.temporal.io/api/enums/v1"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testScheduleState(paused bool, notes string) *ScheduleStateModel {
+	return &ScheduleStateModel{
+		Paused:           types.BoolValue(paused),
+		LimitedActions:   types.BoolValue(false),
+		RemainingActions: types.Int64Value(0),
+		Notes:            types.StringValue(notes),
+	}
+}
+
+func TestSchedulePausePatch(t *testing.T) {
+	limited := testScheduleState(true, "paused for maintenance")
+	limited.LimitedActions = types.BoolValue(true)
+	limited.RemainingActions = types.Int64Value(3)
+
+	cases := []struct {
+		name    string
+		planned *ScheduleStateModel
+		want    *schedulev1.SchedulePatch
+	}{
+		{"pause", testScheduleState(true, "paused for maintenance"), &schedulev1.SchedulePatch{Pause: "paused for maintenance"}},
+		{"unpause", testScheduleState(false, "maintenance done"), &schedulev1.SchedulePatch{Unpause: "maintenance done"}},
+		{"notes only", testScheduleState(false, "owned by team-a"), &schedulev1.SchedulePatch{Unpause: "owned by team-a"}},
+		{"pause without notes", testScheduleState(true, ""), &schedulev1.SchedulePatch{Pause: pauseNote}},
+		{"unpause without notes", testScheduleState(false, ""), &schedulev1.SchedulePatch{Unpause: unpauseNote}},
+		{"action limits", limited, nil},
+	}
+
+	for _, c := range cases {
+		got := schedulePausePatch(c.planned, testScheduleState(false, ""))
+		if (got == nil) != (c.want == nil) || got != nil && !proto.Equal(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestScheduleChangesOnly(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewScheduleResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	model := func(every string, state *ScheduleStateModel) tfsdk.State {
+		s := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := s.Set(ctx, &ScheduleResourceModel{
+			Namespace:        types.StringValue("default"),
+			ScheduleID:       types.StringValue("test-schedule"),
+			Memo:             types.MapNull(types.StringType),
+			MemoJSON:         types.MapNull(jsonType{}),
+			MemoAll:          types.MapValueMust(types.StringType, nil),
+			SearchAttributes: types.MapNull(types.StringType),
+			Spec: &ScheduleSpecModel{
+				Intervals: []IntervalModel{{Every: types.StringValue(every), Offset: types.StringNull()}},
+				StartTime: types.StringNull(),
+				EndTime:   types.StringNull(),
+				Jitter:    types.StringNull(),
+				TimeZone:  types.StringValue("UTC"),
+			},
+			State:       state,
+			ManageState: types.BoolValue(true),
+			Info:        types.ObjectNull(scheduleInfoAttrTypes),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+		return s
+	}
+	prior := model("1h", testScheduleState(false, ""))
+	stateNames := []string{"state", "manage_state", "info"}
+
+	cases := []struct {
+		name    string
+		planned tfsdk.State
+		want    bool
+	}{
+		{"state", model("1h", testScheduleState(true, "paused")), true},
+		{"spec and state", model("2h", testScheduleState(true, "paused")), false},
+		{"spec", model("2h", testScheduleState(false, "")), false},
+		{"unchanged", model("1h", testScheduleState(false, "")), true},
+	}
+
+	for _, c := range cases {
+		got, diags := scheduleChangesOnly(ctx, tfsdk.Plan(c.planned), prior, stateNames...)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diags: %v", c.name, diags)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestConvertToInitialPatch(t *testing.T) {
+	patch, diags := convertToInitialPatch(&ScheduleInitialPatchModel{
+		TriggerImmediately: &TriggerImmediatelyModel{OverlapPolicy: types.StringNull()},
+		Backfill: []BackfillModel{{
+			StartTime:     types.StringValue("2025-01-01T00:00:00Z"),
+			EndTime:       types.StringValue("2025-01-15T00:00:00Z"),
+			OverlapPolicy: types.StringValue("AllowAll"),
+		}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	want := &schedulev1.SchedulePatch{
+		TriggerImmediately: &schedulev1.TriggerImmediatelyRequest{},
+		BackfillRequest: []*schedulev1.BackfillRequest{{
+			StartTime:     timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			EndTime:       timestamppb.New(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)),
+			OverlapPolicy: enums.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		}},
+	}
+	if !proto.Equal(patch, want) {
+		t.Errorf("got %v, want %v", patch, want)
+	}
+
+	patch, diags = convertToInitialPatch(nil)
+	if diags.HasError() || patch != nil {
+		t.Errorf("expected no patch without initial_patch, got %v, %v", patch, diags)
+	}
+}
+
+func TestInitialPatchPlanModifier(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewScheduleResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	objectType := schemaResp.Schema.Attributes["initial_patch"].GetType().(types.ObjectType)
+	patch := func(overlapPolicy string) types.Object {
+		trigger := types.ObjectValueMust(
+			objectType.AttrTypes["trigger_immediately"].(types.ObjectType).AttrTypes,
+			map[string]attr.Value{"overlap_policy": types.StringValue(overlapPolicy)},
+		)
+		return types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"trigger_immediately": trigger,
+			"backfill":            types.ListNull(objectType.AttrTypes["backfill"].(types.ListType).ElemType),
+		})
+	}
+	created := testScheduleResourceState(t)
+	absent := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	cases := []struct {
+		name   string
+		state  tfsdk.State
+		prior  types.Object
+		config types.Object
+		want   types.Object
+	}{
+		{"create", absent, types.ObjectNull(objectType.AttrTypes), patch("AllowAll"), patch("AllowAll")},
+		{"create without initial patch", absent, types.ObjectNull(objectType.AttrTypes), types.ObjectNull(objectType.AttrTypes), types.ObjectNull(objectType.AttrTypes)},
+		{"changed", created, patch("AllowAll"), patch("Skip"), patch("AllowAll")},
+		// Imported schedules and schedules created without initial_patch plan it as configured.
+		{"added", created, types.ObjectNull(objectType.AttrTypes), patch("Skip"), patch("Skip")},
+		{"removed", created, patch("AllowAll"), types.ObjectNull(objectType.AttrTypes), patch("AllowAll")},
+	}
+
+	for _, c := range cases {
+		req := planmodifier.ObjectRequest{
+			Path:        path.Root("initial_patch"),
+			State:       c.state,
+			StateValue:  c.prior,
+			ConfigValue: c.config,
+			PlanValue:   types.ObjectUnknown(objectType.AttrTypes),
+		}
+		resp := planmodifier.ObjectResponse{PlanValue: req.PlanValue}
+		initialPatchPlanModifier{}.PlanModifyObject(ctx, req, &resp)
+		if !resp.PlanValue.Equal(c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, resp.PlanValue, c.want)
+		}
+	}
+}
+
+// TestRefreshFromDescription_DefaultPauseNotes verifies that the notes sent to pause a
+// schedule without configured notes do not read back as drift.
+func TestRefreshFromDescription_DefaultPauseNotes(t *testing.T) {
+	ctx := context.Background()
+	r := &ScheduleResource{namespace: "default"}
+
+	for _, c := range []struct {
+		prior, current, want string
+	}{
+		{"", pauseNote, ""},
+		{"", unpauseNote, ""},
+		{"", "paused in the UI", "paused in the UI"},
+		{"maintenance", pauseNote, pauseNote},
+	} {
+		data := importedScheduleModel("default", "test-schedule")
+		data.State = testScheduleState(true, c.prior)
+		diags := r.refreshFromDescription(ctx, &workflowservice.DescribeScheduleResponse{
+			Schedule: &schedulev1.Schedule{State: &schedulev1.ScheduleState{Paused: true, Notes: c.current}},
+		}, &data)
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+		if got := data.State.Notes.ValueString(); got != c.want {
+			t.Errorf("prior %q, current %q: got notes %q, want %q", c.prior, c.current, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestConvertScheduleSpec_ExcludeCalendar verifies that excluded calendar items are sent as
+// ExcludeCalendar and read back from ExcludeStructuredCalendar like calendar items.
+func TestConvertScheduleSpec_ExcludeCalendar(t *testing.T) {
+	items := []CalendarModel{{
+		Year:       types.StringValue("*"),
+		Month:      types.StringValue("12"),
+		DayOfMonth: types.StringValue("24-26"),
+		DayOfWeek:  types.StringValue("*"),
+		Hour:       types.StringValue("*"),
+		Minute:     types.StringValue("0"),
+		Second:     types.StringValue("0"),
+		Comment:    types.StringValue("holidays"),
+	}}
+
+	spec, diags := convertToScheduleSpec(&ScheduleSpecModel{ExcludeCalendarItems: items})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(spec.GetCalendar()) != 0 || len(spec.GetExcludeCalendar()) != 1 {
+		t.Fatalf("expected one excluded calendar, got %v", spec)
+	}
+	if got := spec.GetExcludeCalendar()[0]; got.GetMonth() != "12" || got.GetDayOfMonth() != "24-26" || got.GetComment() != "holidays" {
+		t.Errorf("unexpected excluded calendar: %v", got)
+	}
+
+	model := convertScheduleSpec(&schedulev1.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulev1.StructuredCalendarSpec{{
+			Second:     []*schedulev1.Range{{Start: 0}},
+			Minute:     []*schedulev1.Range{{Start: 0}},
+			Hour:       []*schedulev1.Range{{Start: 0, End: 23, Step: 1}},
+			DayOfMonth: []*schedulev1.Range{{Start: 24, End: 26, Step: 1}},
+			Month:      []*schedulev1.Range{{Start: 12}},
+			DayOfWeek:  []*schedulev1.Range{{Start: 0, End: 6, Step: 1}},
+			Comment:    "holidays",
+		}},
+	}, nil)
+	if model.CalendarItems != nil {
+		t.Errorf("expected no calendar items, got %v", model.CalendarItems)
+	}
+	// Without a prior expression, every day of the week reads back as the default.
+	want := []CalendarModel{items[0]}
+	want[0].DayOfWeek = types.StringValue("0-6")
+	if !reflect.DeepEqual(model.ExcludeCalendarItems, want) {
+		t.Errorf("got %+v, want %+v", model.ExcludeCalendarItems, want)
+	}
+}
+
+// TestConvertScheduleSpec_ExcludeStructuredCalendar verifies that excluded structured calendar
+// items are sent as ExcludeStructuredCalendar and read back apart from excluded calendar items.
+func TestConvertScheduleSpec_ExcludeStructuredCalendar(t *testing.T) {
+	structured := []StructuredCalendarModel{{
+		Month:      []RangeModel{{Start: types.Int64Value(12), End: types.Int64Null(), Step: types.Int64Null()}},
+		DayOfMonth: []RangeModel{{Start: types.Int64Value(24), End: types.Int64Value(26), Step: types.Int64Null()}},
+		Hour:       []RangeModel{{Start: types.Int64Value(0), End: types.Int64Value(23), Step: types.Int64Null()}},
+		Comment:    types.StringValue("holidays"),
+	}}
+	calendar := []CalendarModel{{
+		Year:       types.StringValue("*"),
+		Month:      types.StringValue("1"),
+		DayOfMonth: types.StringValue("1"),
+		DayOfWeek:  types.StringValue("*"),
+		Hour:       types.StringValue("*"),
+		Minute:     types.StringValue("0"),
+		Second:     types.StringValue("0"),
+		Comment:    types.StringValue(""),
+	}}
+	prior := &ScheduleSpecModel{ExcludeStructuredCalendarItems: structured, ExcludeCalendarItems: calendar}
+
+	spec, diags := convertToScheduleSpec(prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(spec.GetStructuredCalendar()) != 0 || len(spec.GetExcludeCalendar()) != 1 {
+		t.Fatalf("unexpected spec: %v", spec)
+	}
+	got := spec.GetExcludeStructuredCalendar()
+	if len(got) != 1 || formatRanges(got[0].GetMonth()) != "12" || formatRanges(got[0].GetDayOfMonth()) != "24-26" ||
+		got[0].GetComment() != "holidays" {
+		t.Fatalf("unexpected excluded structured calendar: %v", got)
+	}
+
+	// Temporal stores the excluded calendar strings after the excluded structured calendars.
+	stored := append(got, &schedulev1.StructuredCalendarSpec{
+		Second:     []*schedulev1.Range{{Start: 0}},
+		Minute:     []*schedulev1.Range{{Start: 0}},
+		Hour:       []*schedulev1.Range{{Start: 0, End: 23, Step: 1}},
+		DayOfMonth: []*schedulev1.Range{{Start: 1}},
+		Month:      []*schedulev1.Range{{Start: 1}},
+		DayOfWeek:  []*schedulev1.Range{{Start: 0, End: 6, Step: 1}},
+	})
+	model := convertScheduleSpec(&schedulev1.ScheduleSpec{ExcludeStructuredCalendar: stored}, prior)
+	if !reflect.DeepEqual(model.ExcludeStructuredCalendarItems, structured) {
+		t.Errorf("structured: got %+v, want %+v", model.ExcludeStructuredCalendarItems, structured)
+	}
+	if !reflect.DeepEqual(model.ExcludeCalendarItems, calendar) {
+		t.Errorf("calendar: got %+v, want %+v", model.ExcludeCalendarItems, calendar)
+	}
+	if model.StructuredCalendarItems != nil || model.CalendarItems != nil {
+		t.Errorf("expected no calendars, got %+v", model)
+	}
+}
+
+// TestConvertScheduleSpec_CalendarKeepsEquivalentForm verifies that calendar strings
+// Temporal stores in structured form read back as configured when equivalent.
+func TestConvertScheduleSpec_CalendarKeepsEquivalentForm(t *testing.T) {
+	prior := &ScheduleSpecModel{
+		CalendarItems: []CalendarModel{{
+			Year:       types.StringValue("*"),
+			Month:      types.StringValue("*"),
+			DayOfMonth: types.StringValue("*"),
+			DayOfWeek:  types.StringValue("MON-FRI"),
+			Hour:       types.StringValue("9"),
+			Minute:     types.StringValue("*"),
+			Second:     types.StringValue("0"),
+			Comment:    types.StringValue(""),
+		}},
+	}
+
+	stored := &schedulev1.StructuredCalendarSpec{
+		Second:     []*schedulev1.Range{{Start: 0, End: 0, Step: 1}},
+		Minute:     []*schedulev1.Range{{Start: 0, End: 59, Step: 1}},
+		Hour:       []*schedulev1.Range{{Start: 9, End: 9, Step: 1}},
+		DayOfMonth: []*schedulev1.Range{{Start: 1, End: 31, Step: 1}},
+		Month:      []*schedulev1.Range{{Start: 1, End: 12, Step: 1}},
+		DayOfWeek:  []*schedulev1.Range{{Start: 1, End: 5, Step: 1}},
+	}
+	model := convertScheduleSpec(&schedulev1.ScheduleSpec{StructuredCalendar: []*schedulev1.StructuredCalendarSpec{stored}}, prior)
+	if !reflect.DeepEqual(model.CalendarItems, prior.CalendarItems) {
+		t.Errorf("got %+v, want %+v", model.CalendarItems, prior.CalendarItems)
+	}
+
+	stored.Hour = []*schedulev1.Range{{Start: 10, End: 10, Step: 1}}
+	model = convertScheduleSpec(&schedulev1.ScheduleSpec{StructuredCalendar: []*schedulev1.StructuredCalendarSpec{stored}}, prior)
+	if got := model.CalendarItems[0].Hour.ValueString(); got != "10" {
+		t.Errorf("expected the changed hour to be read back, got %q", got)
+	}
+}
+
+// TestConvertScheduleSpec_StructuredCalendar verifies that structured calendar items are sent
+// with calendar defaults for omitted fields and read back apart from calendar items.
+func TestConvertScheduleSpec_StructuredCalendar(t *testing.T) {
+	structured := []StructuredCalendarModel{{
+		DayOfWeek: []RangeModel{{Start: types.Int64Value(1), End: types.Int64Value(5), Step: types.Int64Null()}},
+		Hour:      []RangeModel{{Start: types.Int64Value(0), End: types.Int64Value(23), Step: types.Int64Value(6)}},
+		Comment:   types.StringValue("every 6 hours on weekdays"),
+	}}
+	calendar := []CalendarModel{{
+		Year:       types.StringValue("*"),
+		Month:      types.StringValue("*"),
+		DayOfMonth: types.StringValue("1"),
+		DayOfWeek:  types.StringValue("*"),
+		Hour:       types.StringValue("0"),
+		Minute:     types.StringValue("0"),
+		Second:     types.StringValue("0"),
+		Comment:    types.StringValue(""),
+	}}
+	prior := &ScheduleSpecModel{StructuredCalendarItems: structured, CalendarItems: calendar}
+
+	spec, diags := convertToScheduleSpec(prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	got := spec.GetStructuredCalendar()
+	if len(got) != 1 || formatRanges(got[0].GetMinute()) != "0" || formatRanges(got[0].GetMonth()) != "1-12" ||
+		formatRanges(got[0].GetHour()) != "0-23/6" || len(got[0].GetYear()) != 0 {
+		t.Fatalf("unexpected structured calendar: %v", got)
+	}
+
+	// Temporal stores the calendar strings as structured calendars after the structured ones.
+	monthly, err := calendarDayOfMonth.parse("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := append(got, &schedulev1.StructuredCalendarSpec{
+		Second:     []*schedulev1.Range{{Start: 0, End: 0, Step: 1}},
+		Minute:     []*schedulev1.Range{{Start: 0, End: 0, Step: 1}},
+		Hour:       []*schedulev1.Range{{Start: 0, End: 0, Step: 1}},
+		DayOfMonth: monthly,
+		Month:      []*schedulev1.Range{{Start: 1, End: 12, Step: 1}},
+		DayOfWeek:  []*schedulev1.Range{{Start: 0, End: 6, Step: 1}},
+	})
+	model := convertScheduleSpec(&schedulev1.ScheduleSpec{StructuredCalendar: stored}, prior)
+	if !reflect.DeepEqual(model.StructuredCalendarItems, structured) {
+		t.Errorf("structured: got %+v, want %+v", model.StructuredCalendarItems, structured)
+	}
+	if !reflect.DeepEqual(model.CalendarItems, calendar) {
+		t.Errorf("calendar: got %+v, want %+v", model.CalendarItems, calendar)
+	}
+
+	// Without a prior form, changed ranges are read back explicitly.
+	model = convertScheduleSpec(&schedulev1.ScheduleSpec{StructuredCalendar: got}, &ScheduleSpecModel{
+		StructuredCalendarItems: []StructuredCalendarModel{{}},
+	})
+	want := []RangeModel{{Start: types.Int64Value(0), End: types.Int64Value(23), Step: types.Int64Value(6)}}
+	if !reflect.DeepEqual(model.StructuredCalendarItems[0].Hour, want) {
+		t.Errorf("hour: got %+v, want %+v", model.StructuredCalendarItems[0].Hour, want)
+	}
+}
+
+// TestConvertScheduleSpec_CronItems verifies that cron expressions are sent as cron strings
+// and read back as configured from their structured form, without drift, alongside the other
+// spec items and whatever their order relative to the calendar strings.
+func TestConvertScheduleSpec_CronItems(t *testing.T) {
+	prior := &ScheduleSpecModel{
+		Intervals: []IntervalModel{{Every: types.StringValue("1h")}},
+		CronItems: []types.String{
+			types.StringValue("CRON_TZ=Europe/Berlin 0 9 * * MON-FRI"),
+			types.StringValue("@every 30m"),
+			types.StringValue("@daily"),
+		},
+		CalendarItems: []CalendarModel{{
+			Year:       types.StringValue("*"),
+			Month:      types.StringValue("*"),
+			DayOfMonth: types.StringValue("1"),
+			DayOfWeek:  types.StringValue("*"),
+			Hour:       types.StringValue("0"),
+			Minute:     types.StringValue("0"),
+			Second:     types.StringValue("0"),
+			Comment:    types.StringValue(""),
+		}},
+		TimeZone: types.StringValue("UTC"),
+	}
+
+	spec, diags := convertToScheduleSpec(prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(spec.GetCronString()) != 3 || len(spec.GetStructuredCalendar()) != 0 || len(spec.GetInterval()) != 1 {
+		t.Fatalf("expected the cron expressions to be sent as cron strings, got %v", spec)
+	}
+	if spec.GetTimezoneName() != "Europe/Berlin" {
+		t.Errorf("expected the CRON_TZ time zone, got %q", spec.GetTimezoneName())
+	}
+
+	for _, cronFirst := range []bool{true, false} {
+		stored := storedScheduleSpec(t, spec, cronFirst)
+
+		model := convertScheduleSpec(stored, prior)
+		if !reflect.DeepEqual(model.CronItems, prior.CronItems) {
+			t.Errorf("cron: got %v, want %v", model.CronItems, prior.CronItems)
+		}
+		if !reflect.DeepEqual(model.Intervals, prior.Intervals) {
+			t.Errorf("intervals: got %v, want %v", model.Intervals, prior.Intervals)
+		}
+		if !reflect.DeepEqual(model.CalendarItems, prior.CalendarItems) {
+			t.Errorf("calendar: got %+v, want %+v", model.CalendarItems, prior.CalendarItems)
+		}
+		if model.TimeZone != prior.TimeZone {
+			t.Errorf("time zone: got %v, want %v", model.TimeZone, prior.TimeZone)
+		}
+	}
+
+	// A schedule changed outside Terraform reads back as a different cron expression.
+	stored := storedScheduleSpec(t, spec, true)
+	stored.StructuredCalendar[1].Hour = stored.StructuredCalendar[0].Hour
+	model := convertScheduleSpec(stored, prior)
+	if got := model.CronItems[2].ValueString(); got != "0 0 9 * * * *" {
+		t.Errorf("expected the changed cron expression, got %q", got)
+	}
+	if len(model.CalendarItems) != 1 {
+		t.Errorf("expected the calendar to be kept, got %+v", model.CalendarItems)
+	}
+
+	prior.TimeZone = types.StringValue("America/New_York")
+	if _, diags := convertToScheduleSpec(prior); !diags.HasError() {
+		t.Error("expected an error for a CRON_TZ conflicting with time_zone")
+	}
+}
+
+// storedScheduleSpec returns spec the way Temporal stores it, with calendar strings and cron
+// expressions in structured form, the cron expressions first or last.
+func storedScheduleSpec(t *testing.T, spec *schedulev1.ScheduleSpec, cronFirst bool) *schedulev1.ScheduleSpec {
+	t.Helper()
+	stored := proto.Clone(spec).(*schedulev1.ScheduleSpec)
+	stored.Calendar, stored.CronString = nil, nil
+
+	var calendars, crons []*schedulev1.StructuredCalendarSpec
+	for _, calendar := range spec.GetCalendar() {
+		structured, err := parseCalendarSpec(calendar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		calendars = append(calendars, structured)
+	}
+	for _, s := range spec.GetCronString() {
+		cron, err := parseCronString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cron.calendar != nil {
+			crons = append(crons, cron.calendar)
+		} else {
+			stored.Interval = append(stored.Interval, cron.interval)
+		}
+	}
+
+	if cronFirst {
+		stored.StructuredCalendar = append(append(stored.StructuredCalendar, crons...), calendars...)
+	} else {
+		stored.StructuredCalendar = append(append(stored.StructuredCalendar, calendars...), crons...)
+	}
+	return stored
+}
+
+// TestConvertScheduleSpec_IntervalForms verifies that interval and jitter durations keep
+// their configured form on read.
+func TestConvertScheduleSpec_IntervalForms(t *testing.T) {
+	prior := &ScheduleSpecModel{
+		Intervals: []IntervalModel{{Every: types.StringValue("1h30m"), Offset: types.StringValue("1m30s")}},
+		Jitter:    types.StringValue("1500ms"),
+		TimeZone:  types.StringValue("UTC"),
+	}
+
+	spec, diags := convertToScheduleSpec(prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	model := convertScheduleSpec(spec, prior)
+	if !reflect.DeepEqual(model.Intervals, prior.Intervals) {
+		t.Errorf("intervals: got %v, want %v", model.Intervals, prior.Intervals)
+	}
+	if model.Jitter != prior.Jitter {
+		t.Errorf("jitter: got %v, want %v", model.Jitter, prior.Jitter)
+	}
+}
+
+// TestConvertSchedulePolicy_CatchupWindowForm verifies that the catch-up window keeps its
+// configured form on read.
+func TestConvertSchedulePolicy_CatchupWindowForm(t *testing.T) {
+	prior := &SchedulePolicyModel{
+		Overlap:        types.StringValue("Skip"),
+		CatchupWindow:  types.StringValue("1h30m"),
+		PauseOnFailure: types.BoolValue(false),
+	}
+
+	policies, diags := convertToSchedulePolicy(prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got := convertSchedulePolicy(policies, prior); *got != *prior {
+		t.Errorf("got %+v, want %+v", got, prior)
+	}
+	if got := convertSchedulePolicy(policies, nil).CatchupWindow.ValueString(); got != "90m" {
+		t.Errorf("expected the canonical form 90m without a prior form, got %s", got)
+	}
+}
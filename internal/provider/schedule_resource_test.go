@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccScheduleResource_Basic(t *testing.T) {
@@ -57,6 +58,7 @@ resource "temporal_schedule" "test" {
 					resource.TestCheckResourceAttr("temporal_schedule.test", "schedule_id", scheduleName),
 					resource.TestCheckResourceAttr("temporal_schedule.test", "namespace", "default"),
 					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.intervals.0.every", "24h"),
+					resource.TestCheckResourceAttrSet("temporal_schedule.test", "info.create_time"),
 					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.intervals.0.offset", "1h"),
 					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("temporal_schedule.test", "state.paused", "true"),
@@ -246,3 +248,488 @@ resource "temporal_schedule" "bad" {
 		},
 	})
 }
+
+func TestAccScheduleResource_ProviderNamespace(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	namespace := "provider-ns-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporal" {
+  host      = "127.0.0.1"
+  port      = "7233"
+  insecure  = true
+  namespace = "%[1]s"
+}
+
+resource "temporal_namespace" "test" {
+  name        = "%[1]s"
+  description = "Namespace set on the provider"
+}
+
+resource "temporal_schedule" "test" {
+  schedule_id = "%[2]s"
+
+  spec = {
+    intervals = [{ every = "1h" }]
+  }
+  state         = {}
+  policy_config = {}
+
+  action = {
+    workflow = {
+      workflow_id   = "wf-provider-namespace"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+
+  depends_on = [temporal_namespace.test]
+}
+`, namespace, scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "namespace", namespace),
+				),
+			},
+			{
+				ResourceName:            "temporal_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"info"},
+				ImportStateId:           scheduleName,
+			},
+		},
+	})
+}
+
+func testAccScheduleResourceMemoConfig(scheduleName, owner string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id = "%s"
+
+  spec = {
+    intervals = [{
+      every = "24h"
+    }]
+  }
+
+  state = {}
+
+  policy_config = {}
+
+  memo = {
+    owner = "%s"
+  }
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-memo"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+`, scheduleName, owner)
+}
+
+func TestAccScheduleResource_MemoUpdateInPlace(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourceMemoConfig(scheduleName, "team-a"),
+				Check:  resource.TestCheckResourceAttr("temporal_schedule.test", "memo.owner", "team-a"),
+			},
+			{
+				Config: testAccScheduleResourceMemoConfig(scheduleName, "team-b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("temporal_schedule.test", "memo.owner", "team-b"),
+			},
+		},
+	})
+}
+
+func TestAccScheduleResource_CalendarAndCronItems(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The post-apply plan must be empty although Temporal stores these in structured form.
+				Config: providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  namespace   = "default"
+  schedule_id = "%s"
+
+  spec = {
+    cron_items = ["CRON_TZ=Europe/Berlin 0 9 * * MON-FRI", "@every 12h"]
+
+    calendar_items = [{
+      day_of_week = "SAT,SUN"
+      hour        = "10"
+      minute      = "*/30"
+    }]
+
+    structured_calendar_items = [{
+      day_of_month = [{ start = 1, end = 31, step = 2 }]
+      hour         = [{ start = 6 }]
+    }]
+
+    exclude_calendar_items = [{
+      month        = "DEC"
+      day_of_month = "25"
+      hour         = "*"
+      minute       = "*"
+    }]
+
+    exclude_structured_calendar_items = [{
+      month        = [{ start = 1 }]
+      day_of_month = [{ start = 1 }]
+      hour         = [{ start = 0, end = 23 }]
+    }]
+  }
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-1"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+`, scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.cron_items.0", "CRON_TZ=Europe/Berlin 0 9 * * MON-FRI"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.calendar_items.0.day_of_week", "SAT,SUN"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.exclude_structured_calendar_items.0.month.0.start", "1"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.time_zone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleResourceUnmanagedStateConfig(scheduleName string, every string, paused bool, notes string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id  = "%s"
+  manage_state = false
+
+  spec = {
+    intervals = [{
+      every = "%s"
+    }]
+  }
+
+  state = {
+    paused = %t
+    notes  = "%s"
+  }
+
+  policy_config = {}
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-state"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+`, scheduleName, every, paused, notes)
+}
+
+func TestAccScheduleResource_UnmanagedState(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// State is applied on creation
+			{
+				Config: testAccScheduleResourceUnmanagedStateConfig(scheduleName, "24h", true, "paused on creation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "manage_state", "false"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "info.paused", "true"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "info.notes", "paused on creation"),
+				),
+			},
+			// Later state changes are stored but not sent with the update
+			{
+				Config: testAccScheduleResourceUnmanagedStateConfig(scheduleName, "12h", false, "unpaused"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "spec.intervals.0.every", "12h"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "state.paused", "false"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "info.paused", "true"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "info.notes", "paused on creation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleResourcePausedConfig(scheduleName string, paused bool, notes string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id = "%s"
+
+  spec = {
+    intervals = [{
+      every = "24h"
+    }]
+  }
+
+  state = {
+    paused = %t
+    notes  = "%s"
+  }
+
+  policy_config = {}
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-pause"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+`, scheduleName, paused, notes)
+}
+
+func TestAccScheduleResource_PauseAndUnpause(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourcePausedConfig(scheduleName, false, ""),
+				Check:  resource.TestCheckResourceAttr("temporal_schedule.test", "info.paused", "false"),
+			},
+			// Pausing with notes patches the schedule state; the refresh after apply would
+			// show drift if the patch was not applied
+			{
+				Config: testAccScheduleResourcePausedConfig(scheduleName, true, "paused by terraform for maintenance"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "state.paused", "true"),
+				),
+			},
+			{
+				Config: testAccScheduleResourcePausedConfig(scheduleName, false, "maintenance done"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "state.paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleResourceInitialPatchConfig(scheduleName, backfillStart string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id = "%s"
+
+  spec = {
+    calendar_items = [{
+      hour   = "6"
+      minute = "0"
+    }]
+  }
+
+  state = {}
+
+  policy_config = {
+    overlap_policy = "AllowAll"
+  }
+
+  initial_patch = {
+    backfill = [{
+      start_time = "%s"
+      end_time   = "2025-01-04T00:00:00Z"
+    }]
+  }
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-backfill"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+    }
+  }
+}
+`, scheduleName, backfillStart)
+}
+
+func TestAccScheduleResource_InitialPatch(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourceInitialPatchConfig(scheduleName, "2025-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.test", "initial_patch.backfill.#", "1"),
+					resource.TestCheckResourceAttr("temporal_schedule.test", "initial_patch.backfill.0.start_time", "2025-01-01T00:00:00Z"),
+				),
+			},
+			// Changing initial_patch after creation keeps the value used at creation
+			{
+				Config: testAccScheduleResourceInitialPatchConfig(scheduleName, "2025-01-02T00:00:00Z"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("temporal_schedule.test", "initial_patch.backfill.0.start_time", "2025-01-01T00:00:00Z"),
+			},
+		},
+	})
+}
+
+// TestAccScheduleResource_ImportWithInitialPatch verifies that a configured initial_patch can
+// be planned for an imported schedule, which has none.
+func TestAccScheduleResource_ImportWithInitialPatch(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourceInitialPatchConfig(scheduleName, "2025-01-01T00:00:00Z"),
+			},
+			{
+				Config:          testAccScheduleResourceInitialPatchConfig(scheduleName, "2025-01-01T00:00:00Z"),
+				ResourceName:    "temporal_schedule.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   fmt.Sprintf("default:%s", scheduleName),
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Only initial_patch is set, which sends no request to Temporal.
+						plancheck.ExpectResourceAction("temporal_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccScheduleResource_InvalidBackfillRange(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleResourceInitialPatchConfig(scheduleName, "2025-01-05T00:00:00Z"),
+				ExpectError: regexp.MustCompile("Invalid End Time"),
+			},
+		},
+	})
+}
+
+func testAccScheduleResourceImportConfig(scheduleName string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "temporal_schedule" "test" {
+  schedule_id = "%s"
+
+  memo = {
+    owner = "platform-team"
+  }
+
+  spec = {
+    calendar_items = [{
+      hour   = "9"
+      minute = "30"
+    }]
+    intervals = [{
+      every = "48h"
+    }]
+    time_zone = "Europe/Berlin"
+  }
+
+  state = {
+    paused = true
+    notes  = "paused for import"
+  }
+
+  policy_config = {
+    overlap_policy = "BufferOne"
+    catchup_window = "8760h"
+  }
+
+  action = {
+    workflow = {
+      workflow_id   = "test-workflow-import"
+      workflow_type = "TestWorkflow"
+      task_queue    = "test-queue"
+      input = jsonencode({
+        days = 7
+      })
+    }
+  }
+}
+`, scheduleName)
+}
+
+// TestAccScheduleResource_ImportComplete verifies that import reads the complete schedule:
+// import blocks plan no changes, and -generate-config-out writes a valid configuration.
+func TestAccScheduleResource_ImportComplete(t *testing.T) {
+	scheduleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourceImportConfig(scheduleName),
+			},
+			{
+				Config:                  testAccScheduleResourceImportConfig(scheduleName),
+				ResourceName:            "temporal_schedule.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("default:%s", scheduleName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"info"},
+			},
+			{
+				Config:          testAccScheduleResourceImportConfig(scheduleName),
+				ResourceName:    "temporal_schedule.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   fmt.Sprintf("default:%s", scheduleName),
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config:          providerConfig,
+				ResourceName:    "temporal_schedule.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   fmt.Sprintf("default:%s", scheduleName),
+				GenerateConfig:  true,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.generated", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
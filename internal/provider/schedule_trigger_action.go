@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// Ensures that ScheduleTriggerAction fully satisfies the action.Action and
+// action.ActionWithConfigure interfaces.
+var (
+	_ action.Action              = &ScheduleTriggerAction{}
+	_ action.ActionWithConfigure = &ScheduleTriggerAction{}
+)
+
+// NewScheduleTriggerAction returns a new instance of the ScheduleTriggerAction.
+func NewScheduleTriggerAction() action.Action {
+	return &ScheduleTriggerAction{}
+}
+
+// ScheduleTriggerAction starts the action of an existing schedule immediately.
+type ScheduleTriggerAction struct {
+	scheduleActionClient
+}
+
+// ScheduleTriggerActionModel defines the configuration of the trigger action.
+type ScheduleTriggerActionModel struct {
+	Namespace     types.String `tfsdk:"namespace"`
+	ScheduleID    types.String `tfsdk:"schedule_id"`
+	OverlapPolicy types.String `tfsdk:"overlap_policy"`
+}
+
+// Metadata sets the metadata for the Temporal schedule trigger action, specifically the type name.
+func (a *ScheduleTriggerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_trigger"
+}
+
+// Schema defines the schema for the Temporal schedule trigger action.
+func (a *ScheduleTriggerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs the action of an existing schedule once, immediately, e.g. after deploying a new workflow version",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace":   scheduleActionNamespaceAttribute(),
+			"schedule_id": scheduleActionScheduleIDAttribute(),
+			"overlap_policy": schema.StringAttribute{
+				MarkdownDescription: "Policy for a workflow of the schedule that is still running. Defaults to the overlap policy of the schedule. " +
+					"Accepted values: Skip, BufferOne, BufferAll, CancelOther, TerminateOther, AllowAll",
+				Optional:   true,
+				Validators: scheduleActionOverlapPolicyValidators(),
+			},
+		},
+	}
+}
+
+// Invoke triggers the schedule.
+func (a *ScheduleTriggerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ScheduleTriggerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch := &schedulev1.SchedulePatch{
+		TriggerImmediately: &schedulev1.TriggerImmediatelyRequest{
+			OverlapPolicy: ScheduleOverlapPolicy[data.OverlapPolicy.ValueString()],
+		},
+	}
+	resp.Diagnostics.Append(a.patchSchedule(ctx, "trigger", data.Namespace, data.ScheduleID.ValueString(), patch)...)
+}
+
+// scheduleActionClient holds the provider data shared by the schedule actions.
+type scheduleActionClient struct {
+	client    grpc.ClientConnInterface
+	namespace string
+	readOnly  bool
+}
+
+// Configure sets up the schedule action configuration.
+func (c *scheduleActionClient) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	c.client = providerData.Conn
+	c.namespace = providerData.Namespace
+	c.readOnly = providerData.ReadOnly
+}
+
+// patchSchedule applies patch to a schedule, unless the provider is read-only. The schedule
+// is in the provider namespace when namespace is null.
+func (c *scheduleActionClient) patchSchedule(ctx context.Context, operation string, namespace types.String, scheduleID string, patch *schedulev1.SchedulePatch) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if rejectReadOnly(ctx, c.readOnly, operation+" the schedule", &diags) {
+		return diags
+	}
+
+	ns := namespace.ValueString()
+	if namespace.IsNull() {
+		ns = c.namespace
+	}
+
+	u, err := uuid.GenerateUUID()
+	if err != nil {
+		diags.AddError("UUID Generation Error", err.Error())
+		return diags
+	}
+
+	client := workflowservice.NewWorkflowServiceClient(c.client)
+	_, err = client.PatchSchedule(ctx, &workflowservice.PatchScheduleRequest{
+		RequestId:  u,
+		Namespace:  ns,
+		ScheduleId: scheduleID,
+		Patch:      patch,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Patching Schedule",
+			fmt.Sprintf("Could not %s schedule %s in namespace %s: %s", operation, scheduleID, ns, err.Error()),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Invoked %s of schedule: %s in namespace: %s", operation, scheduleID, ns))
+	return diags
+}
+
+func scheduleActionNamespaceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Namespace of the schedule. If this is not provided, the provider namespace will be used",
+		Optional:            true,
+	}
+}
+
+func scheduleActionScheduleIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the schedule, e.g. `temporal_schedule.example.schedule_id`",
+		Required:            true,
+	}
+}
+
+// scheduleActionOverlapPolicyValidators accept the overlap policies of policy_config.
+func scheduleActionOverlapPolicyValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(
+			"Skip",
+			"BufferOne",
+			"BufferAll",
+			"CancelOther",
+			"TerminateOther",
+			"AllowAll",
+		),
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// patchScheduleConn records PatchSchedule requests.
+type patchScheduleConn struct {
+	grpc.ClientConnInterface
+	requests []*workflowservice.PatchScheduleRequest
+}
+
+func (c *patchScheduleConn) Invoke(_ context.Context, _ string, args any, _ any, _ ...grpc.CallOption) error {
+	c.requests = append(c.requests, proto.Clone(args.(*workflowservice.PatchScheduleRequest)).(*workflowservice.PatchScheduleRequest))
+	return nil
+}
+
+// invokeScheduleAction configures a with the given provider data and invokes it with values.
+func invokeScheduleAction(t *testing.T, a action.ActionWithConfigure, providerData *TemporalProviderData, values map[string]tftypes.Value) action.InvokeResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp action.ConfigureResponse
+	a.Configure(ctx, action.ConfigureRequest{ProviderData: providerData}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	for name, typ := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+	return resp
+}
+
+func TestScheduleTriggerAction(t *testing.T) {
+	conn := &patchScheduleConn{}
+	resp := invokeScheduleAction(t, NewScheduleTriggerAction().(action.ActionWithConfigure),
+		&TemporalProviderData{Conn: conn, Namespace: "default"},
+		map[string]tftypes.Value{
+			"schedule_id":    tftypes.NewValue(tftypes.String, "test-schedule"),
+			"overlap_policy": tftypes.NewValue(tftypes.String, "AllowAll"),
+		})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+
+	if len(conn.requests) != 1 {
+		t.Fatalf("expected 1 PatchSchedule request, got %d", len(conn.requests))
+	}
+	req := conn.requests[0]
+	if req.GetNamespace() != "default" || req.GetScheduleId() != "test-schedule" {
+		t.Errorf("unexpected schedule %s/%s", req.GetNamespace(), req.GetScheduleId())
+	}
+	if got := req.GetPatch().GetTriggerImmediately().GetOverlapPolicy(); got != enums.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL {
+		t.Errorf("expected overlap policy AllowAll, got %s", got)
+	}
+}
+
+func TestScheduleTriggerAction_ReadOnly(t *testing.T) {
+	conn := &patchScheduleConn{}
+	resp := invokeScheduleAction(t, NewScheduleTriggerAction().(action.ActionWithConfigure),
+		&TemporalProviderData{Conn: conn, Namespace: "default", ReadOnly: true},
+		map[string]tftypes.Value{
+			"schedule_id": tftypes.NewValue(tftypes.String, "test-schedule"),
+		})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Provider Is Read-Only" {
+		t.Fatalf("expected read-only error, got %v", resp.Diagnostics)
+	}
+	if len(conn.requests) != 0 {
+		t.Errorf("expected no PatchSchedule request, got %d", len(conn.requests))
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// Embed the time zone database so time zones validate the same on every host.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// validDuration returns a validator that checks that a string is a non-negative Go duration
+// such as "90s" or "1h30m". Errors use summary, matching the error raised at apply time.
+func validDuration(summary string) validator.String {
+	return durationValidator{summary: summary}
+}
+
+// validPositiveDuration is like validDuration but also rejects a zero duration.
+func validPositiveDuration(summary string) validator.String {
+	return durationValidator{summary: summary, positive: true}
+}
+
+type durationValidator struct {
+	summary  string
+	positive bool
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	if v.positive {
+		return "value must be a positive duration, e.g. 90s or 1h30m"
+	}
+	return "value must be a duration, e.g. 90s or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	switch {
+	case err != nil:
+	case v.positive && d <= 0:
+		err = fmt.Errorf("duration must be positive")
+	case d < 0:
+		err = fmt.Errorf("duration must not be negative")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.summary,
+			fmt.Sprintf("Unable to parse duration: %s. Error: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// validRFC3339 returns a validator that checks that a string is an RFC 3339 timestamp.
+func validRFC3339(summary string) validator.String {
+	return rfc3339Validator{summary: summary}
+}
+
+type rfc3339Validator struct {
+	summary string
+}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp, e.g. 2025-01-01T00:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.summary,
+			fmt.Sprintf("Unable to parse time: %s. Error: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// validTimeZone returns a validator that checks that a string is an IANA time zone name.
+func validTimeZone() validator.String {
+	return timeZoneValidator{}
+}
+
+type timeZoneValidator struct{}
+
+func (v timeZoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name, e.g. Europe/Berlin"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("Unknown time zone: %s. Use an IANA time zone name such as Europe/Berlin.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// validCalendarField returns a validator that checks a calendar expression field, e.g. that
+// hours are within 0-23 and months are numbers or names such as JAN.
+func validCalendarField(field calendarField) validator.String {
+	return calendarFieldValidator{field: field}
+}
+
+type calendarFieldValidator struct {
+	field calendarField
+}
+
+func (v calendarFieldValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a %s expression with values %d-%d", v.field.name, v.field.min, v.field.max)
+}
+
+func (v calendarFieldValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v calendarFieldValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := v.field.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Calendar Expression", err.Error())
+	}
+}
+
+// validCronString returns a validator that checks a cron expression, including the time
+// zone of a CRON_TZ= prefix.
+func validCronString() validator.String {
+	return cronStringValidator{}
+}
+
+type cronStringValidator struct{}
+
+func (v cronStringValidator) Description(_ context.Context) string {
+	return "value must be a cron expression"
+}
+
+func (v cronStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	cron, err := parseCronString(req.ConfigValue.ValueString())
+	if err == nil && cron.timeZone != "" {
+		if _, tzErr := time.LoadLocation(cron.timeZone); tzErr != nil {
+			err = fmt.Errorf("unknown time zone %s", cron.timeZone)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("Unable to parse cron expression: %s. Error: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// validScheduleSpec returns a validator that checks that a schedule spec sets at least one
+// kind of action times, that start_time is before end_time and that a configured time_zone
+// matches the CRON_TZ= prefixes of cron_items. The time zone is checked against the config,
+// since the planned time_zone is "UTC" when it is not set.
+func validScheduleSpec() validator.Object {
+	return scheduleSpecValidator{}
+}
+
+type scheduleSpecValidator struct{}
+
+func (v scheduleSpecValidator) Description(_ context.Context) string {
+	return "spec must set intervals, calendar_items, structured_calendar_items or cron_items, start_time must be before end_time " +
+		"and time_zone must match the time zone of cron_items"
+}
+
+func (v scheduleSpecValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scheduleSpecValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	set := false
+	for _, name := range []string{"intervals", "calendar_items", "structured_calendar_items", "cron_items"} {
+		value, ok := attrs[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+		list, isList := value.(basetypes.ListValue)
+		set = set || value.IsUnknown() || !isList || len(list.Elements()) > 0
+	}
+	if !set {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Schedule Times",
+			"At least one of intervals, calendar_items, structured_calendar_items or cron_items must be set.",
+		)
+	}
+
+	start, startOK := configTime(attrs["start_time"])
+	end, endOK := configTime(attrs["end_time"])
+	if startOK && endOK && !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("end_time"),
+			"Invalid End Time",
+			fmt.Sprintf("end_time %s must be after start_time %s.", end.Format(time.RFC3339), start.Format(time.RFC3339)),
+		)
+	}
+
+	timeZone, ok := attrs["time_zone"].(basetypes.StringValue)
+	if !ok || timeZone.IsNull() || timeZone.IsUnknown() {
+		return
+	}
+	cronItems, ok := attrs["cron_items"].(basetypes.ListValue)
+	if !ok {
+		return
+	}
+	for _, item := range cronItems.Elements() {
+		value, ok := item.(basetypes.StringValue)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		// Invalid expressions are reported by the cron_items validator.
+		cron, err := parseCronString(value.ValueString())
+		if err != nil || cron.timeZone == "" || cron.timeZone == timeZone.ValueString() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("time_zone"),
+			"Conflicting Time Zone",
+			fmt.Sprintf("Cron expressions set time zone %s, but time_zone is %s. Remove time_zone or set it to %s.",
+				cron.timeZone, timeZone.ValueString(), cron.timeZone),
+		)
+		return
+	}
+}
+
+// validTimeRange returns a validator that checks that the end_time of an object is after
+// its start_time.
+func validTimeRange() validator.Object {
+	return timeRangeValidator{}
+}
+
+type timeRangeValidator struct{}
+
+func (v timeRangeValidator) Description(_ context.Context) string {
+	return "end_time must be after start_time"
+}
+
+func (v timeRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeRangeValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	start, startOK := configTime(attrs["start_time"])
+	end, endOK := configTime(attrs["end_time"])
+	if startOK && endOK && !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("end_time"),
+			"Invalid End Time",
+			fmt.Sprintf("end_time %s must be after start_time %s.", end.Format(time.RFC3339), start.Format(time.RFC3339)),
+		)
+	}
+}
+
+// configTime returns the RFC 3339 timestamp held by value, if it is a known, valid one.
+func configTime(value attr.Value) (time.Time, bool) {
+	s, ok := value.(basetypes.StringValue)
+	if !ok || s.IsNull() || s.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, s.ValueString())
+	return t, err == nil
+}
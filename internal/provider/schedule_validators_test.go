@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validateString(v validator.String, value string) bool {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.StringValue(value),
+	}, resp)
+	return !resp.Diagnostics.HasError()
+}
+
+// TestScheduleStringValidators verifies the plan-time checks of schedule string attributes.
+func TestScheduleStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		valid     []string
+		invalid   []string
+	}{
+		{"duration", validDuration("Invalid Jitter"), []string{"0s", "90s", "1h30m"}, []string{"7d", "-1m", "soon"}},
+		{"positive duration", validPositiveDuration("Invalid Interval Duration"), []string{"1m"}, []string{"0s"}},
+		{"rfc3339", validRFC3339("Invalid Start Time"), []string{"2025-01-01T10:00:00+02:00"}, []string{"2025-01-01", "tomorrow"}},
+		{"time zone", validTimeZone(), []string{"UTC", "Europe/Berlin"}, []string{"Mars/Olympus", "CEST"}},
+		{"hour", validCalendarField(calendarHour), []string{"9", "9-17", "*/2"}, []string{"24", "nine"}},
+		{"month", validCalendarField(calendarMonth), []string{"JAN-MAR", "december", "*"}, []string{"0", "13", "JA"}},
+		{"day of week", validCalendarField(calendarDayOfWeek), []string{"MON-FRI", "0,7"}, []string{"8"}},
+		{"cron", validCronString(), []string{"0 9 * * 1-5", "@daily", "CRON_TZ=Asia/Tokyo 0 9 * * *"}, []string{"0 9 * *", "CRON_TZ=Nowhere/City @daily"}},
+	}
+	for _, tt := range tests {
+		for _, value := range tt.valid {
+			if !validateString(tt.validator, value) {
+				t.Errorf("%s: expected %q to be valid", tt.name, value)
+			}
+		}
+		for _, value := range tt.invalid {
+			if validateString(tt.validator, value) {
+				t.Errorf("%s: expected %q to be invalid", tt.name, value)
+			}
+		}
+	}
+}
+
+// TestScheduleSpecValidator verifies that a spec needs action times, a start_time before
+// its end_time and a time_zone matching the time zone of its cron expressions.
+func TestScheduleSpecValidator(t *testing.T) {
+	listType := types.ListType{ElemType: types.StringType}
+	attrTypes := map[string]attr.Type{
+		"intervals":                 listType,
+		"calendar_items":            listType,
+		"structured_calendar_items": listType,
+		"cron_items":                listType,
+		"start_time":                types.StringType,
+		"end_time":                  types.StringType,
+		"time_zone":                 types.StringType,
+	}
+	spec := func(cron attr.Value, start, end string, timeZone types.String) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"intervals":                 types.ListNull(types.StringType),
+			"calendar_items":            types.ListNull(types.StringType),
+			"structured_calendar_items": types.ListNull(types.StringType),
+			"cron_items":                cron,
+			"start_time":                types.StringValue(start),
+			"end_time":                  types.StringValue(end),
+			"time_zone":                 timeZone,
+		})
+	}
+	daily := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("@daily")})
+	berlin := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("CRON_TZ=Europe/Berlin @daily")})
+	noTimeZone := types.StringNull()
+
+	tests := []struct {
+		name  string
+		spec  types.Object
+		valid bool
+	}{
+		{"valid", spec(daily, "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", noTimeZone), true},
+		{"unknown times", spec(types.ListUnknown(types.StringType), "", "", noTimeZone), true},
+		{"no times", spec(types.ListNull(types.StringType), "", "", noTimeZone), false},
+		{"empty cron list", spec(types.ListValueMust(types.StringType, nil), "", "", noTimeZone), false},
+		{"end before start", spec(daily, "2026-01-01T00:00:00Z", "2025-01-01T00:00:00Z", noTimeZone), false},
+		{"cron time zone", spec(berlin, "", "", noTimeZone), true},
+		{"matching time zone", spec(berlin, "", "", types.StringValue("Europe/Berlin")), true},
+		{"conflicting time zone", spec(berlin, "", "", types.StringValue("Asia/Tokyo")), false},
+		{"explicit UTC", spec(berlin, "", "", types.StringValue("UTC")), false},
+		{"time zone without cron time zone", spec(daily, "", "", types.StringValue("Asia/Tokyo")), true},
+	}
+	for _, tt := range tests {
+		resp := &validator.ObjectResponse{}
+		validScheduleSpec().ValidateObject(context.Background(), validator.ObjectRequest{
+			Path:        path.Root("spec"),
+			ConfigValue: tt.spec,
+		}, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("%s: got %v", tt.name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTimeRangeValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"start_time": types.StringType,
+		"end_time":   types.StringType,
+	}
+	timeRange := func(start, end attr.Value) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{"start_time": start, "end_time": end})
+	}
+
+	tests := []struct {
+		name  string
+		value types.Object
+		valid bool
+	}{
+		{"valid", timeRange(types.StringValue("2025-01-01T00:00:00Z"), types.StringValue("2025-01-02T00:00:00Z")), true},
+		{"unknown end", timeRange(types.StringValue("2025-01-01T00:00:00Z"), types.StringUnknown()), true},
+		{"empty", timeRange(types.StringValue("2025-01-01T00:00:00Z"), types.StringValue("2025-01-01T00:00:00Z")), false},
+		{"end before start", timeRange(types.StringValue("2025-01-02T00:00:00Z"), types.StringValue("2025-01-01T00:00:00Z")), false},
+	}
+	for _, tt := range tests {
+		resp := &validator.ObjectResponse{}
+		validTimeRange().ValidateObject(context.Background(), validator.ObjectRequest{
+			Path:        path.Root("initial_patch").AtName("backfill").AtListIndex(0),
+			ConfigValue: tt.value,
+		}, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("%s: got %v", tt.name, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	schedulev1 "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// schedulesPageSize is the number of schedules requested per ListSchedules page.
+const schedulesPageSize = 100
+
+// Ensures that SchedulesDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &SchedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SchedulesDataSource{}
+)
+
+// NewSchedulesDataSource returns a new instance of the SchedulesDataSource.
+func NewSchedulesDataSource() datasource.DataSource {
+	return &SchedulesDataSource{}
+}
+
+// SchedulesDataSource implements the Terraform data source interface listing Temporal schedules.
+type SchedulesDataSource struct {
+	client    grpc.ClientConnInterface
+	namespace string
+}
+
+// SchedulesDataSourceModel defines the structure for the data source's configuration and read data.
+type SchedulesDataSourceModel struct {
+	Namespace types.String           `tfsdk:"namespace"`
+	Query     types.String           `tfsdk:"query"`
+	Schedules []ScheduleSummaryModel `tfsdk:"schedules"`
+}
+
+// ScheduleSummaryModel describes a schedule returned by ListSchedules.
+type ScheduleSummaryModel struct {
+	ScheduleID      types.String   `tfsdk:"schedule_id"`
+	WorkflowType    types.String   `tfsdk:"workflow_type"`
+	Paused          types.Bool     `tfsdk:"paused"`
+	NextActionTimes []types.String `tfsdk:"next_action_times"`
+}
+
+// Metadata sets the metadata for the Temporal schedules data source, specifically the type name.
+func (d *SchedulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+// Schema defines the schema for the Temporal schedules data source.
+func (d *SchedulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Temporal schedules of a namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace to list schedules in. If this is not provided, the provider namespace will be used",
+				Optional:            true,
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Visibility query to filter schedules, e.g. `TemporalSchedulePaused = true` or a custom search attribute set on the schedules",
+				Optional:            true,
+			},
+			"schedules": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching schedules",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_id": schema.StringAttribute{
+							MarkdownDescription: "Schedule ID",
+							Computed:            true,
+						},
+						"workflow_type": schema.StringAttribute{
+							MarkdownDescription: "Workflow type started by the schedule",
+							Computed:            true,
+						},
+						"paused": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schedule is paused",
+							Computed:            true,
+						},
+						"next_action_times": schema.ListAttribute{
+							MarkdownDescription: "Next times the schedule will take an action (RFC3339)",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the schedules data source configuration.
+func (d *SchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Schedules DataSource")
+
+	// Prevent panic if the provider has not been configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Conn
+	d.namespace = providerData.Namespace
+
+	tflog.Info(ctx, "Configured Temporal Schedules client", map[string]any{"success": true})
+}
+
+// Read lists the schedules matching the query, following every result page.
+func (d *SchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Schedules")
+
+	var data SchedulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the user has not provided a namespace for the data source, use the provider namespace
+	if data.Namespace.IsNull() {
+		data.Namespace = types.StringValue(d.namespace)
+	}
+
+	client := workflowservice.NewWorkflowServiceClient(d.client)
+	data.Schedules = []ScheduleSummaryModel{}
+	var pageToken []byte
+	for {
+		listResp, err := client.ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+			Namespace:       data.Namespace.ValueString(),
+			MaximumPageSize: schedulesPageSize,
+			NextPageToken:   pageToken,
+			Query:           data.Query.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Schedules",
+				fmt.Sprintf("Could not list schedules in namespace %s: %s", data.Namespace.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		for _, entry := range listResp.GetSchedules() {
+			data.Schedules = append(data.Schedules, convertScheduleListEntry(entry))
+		}
+
+		pageToken = listResp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "Schedules data source read successfully", map[string]any{"namespace": data.Namespace.ValueString(), "count": len(data.Schedules)})
+}
+
+// convertScheduleListEntry converts a ListSchedules entry to the schedule summary model.
+func convertScheduleListEntry(entry *schedulev1.ScheduleListEntry) ScheduleSummaryModel {
+	summary := ScheduleSummaryModel{
+		ScheduleID:      types.StringValue(entry.GetScheduleId()),
+		WorkflowType:    types.StringValue(entry.GetInfo().GetWorkflowType().GetName()),
+		Paused:          types.BoolValue(entry.GetInfo().GetPaused()),
+		NextActionTimes: []types.String{},
+	}
+	for _, t := range entry.GetInfo().GetFutureActionTimes() {
+		summary.NextActionTimes = append(summary.NextActionTimes, formatTimestamp(t))
+	}
+
+	return summary
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
-	"google.golang.org/grpc"
 )
 
 // Ensures that SearchAttributeDataSource fully satisfies the datasource.DataSource and
@@ -28,7 +27,8 @@ func NewSearchAttributeDataSource() datasource.DataSource {
 
 // SearchAttributeDataSource implements the Terraform data source interface for Temporal SearchAttributes.
 type SearchAttributeDataSource struct {
-	client operatorservice.OperatorServiceClient
+	client    operatorservice.OperatorServiceClient
+	namespace string
 }
 
 // SearchAttributeDataSourceModel defines the structure for the data source's configuration and read data.
@@ -59,8 +59,9 @@ func (d *SearchAttributeDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:            true,
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace with which the Search Attribute is associated. If this is not provided, 'default' will be used",
+				MarkdownDescription: "Namespace with which the Search Attribute is associated. If this is not provided, the provider namespace will be used",
 				Optional:            true,
+				Computed:            true,
 			},
 		},
 	}
@@ -75,17 +76,18 @@ func (d *SearchAttributeDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	connection, ok := req.ProviderData.(grpc.ClientConnInterface)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client := operatorservice.NewOperatorServiceClient(connection)
+	client := operatorservice.NewOperatorServiceClient(providerData.Conn)
 	d.client = client
+	d.namespace = providerData.Namespace
 
 	tflog.Info(ctx, "Configured Temporal Search Attribute client", map[string]any{"success": true})
 }
@@ -110,9 +112,9 @@ func (d *SearchAttributeDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	// If the user has not provided a namespace for the data source, use 'default'
+	// If the user has not provided a namespace for the data source, use the provider namespace
 	if namespace.IsNull() {
-		namespace = types.StringValue("default")
+		namespace = types.StringValue(d.namespace)
 	}
 
 	request := &operatorservice.ListSearchAttributesRequest{
@@ -9,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &SearchAttributeResource{}
 	_ resource.ResourceWithConfigure   = &SearchAttributeResource{}
 	_ resource.ResourceWithImportState = &SearchAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &SearchAttributeResource{}
 
 	// namespaceLocks serializes search attribute mutations per namespace.
 	// Concurrent AddSearchAttributes calls to the same namespace return success
@@ -80,7 +82,9 @@ func NewSearchAttributeResource() resource.Resource {
 
 // SearchAttributeResource - a Temporal search attribute resource implementation.
 type SearchAttributeResource struct {
-	client grpc.ClientConnInterface
+	client    grpc.ClientConnInterface
+	namespace string
+	readOnly  bool
 }
 
 // SearchAttributeResourceModel defines the data schema for a Temporal search attribute resource.
@@ -114,10 +118,13 @@ func (r *SearchAttributeResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace with which the Search Attribute is associated",
+				MarkdownDescription: "Namespace with which the Search Attribute is associated. If this is not provided, the provider namespace will be used",
 				Optional:            true,
-				Default:             stringdefault.StaticString("default"),
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -132,21 +139,32 @@ func (r *SearchAttributeResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(grpc.ClientConnInterface)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Conn
+	r.namespace = providerData.Namespace
+	r.readOnly = providerData.ReadOnly
 	tflog.Info(ctx, "Configured Temporal Search Attribute client", map[string]any{"success": true})
 }
 
+// ModifyPlan fills in the provider namespace when the search attribute does not set one.
+func (r *SearchAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderNamespace(ctx, r.namespace, req, resp)
+}
+
 // Create is responsible for creating a new search attribute in Temporal.
 func (r *SearchAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "create the search attribute", &resp.Diagnostics) {
+		return
+	}
+
 	var data SearchAttributeResourceModel
 
 	client := operatorservice.NewOperatorServiceClient(r.client)
@@ -266,6 +284,9 @@ func (r *SearchAttributeResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update is a no-op method that handles update calls without making any changes.
 func (r *SearchAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "update the search attribute", &resp.Diagnostics) {
+		return
+	}
 
 	tflog.Warn(ctx, "Update operation called, but updates are not supported for this resource.")
 
@@ -273,6 +294,10 @@ func (r *SearchAttributeResource) Update(ctx context.Context, req resource.Updat
 
 // Delete removes a Temporal search attribute from both Temporal and the Terraform state.
 func (r *SearchAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if rejectReadOnly(ctx, r.readOnly, "delete the search attribute", &resp.Diagnostics) {
+		return
+	}
+
 	var data SearchAttributeResourceModel
 
 	client := operatorservice.NewOperatorServiceClient(r.client)
@@ -310,15 +335,15 @@ func (r *SearchAttributeResource) ImportState(ctx context.Context, req resource.
 
 	// Expected request ID format is either 'namespace:search_attribute_name' or 'search_attribute_name'
 	// Ex: 'default:CustomBool' or 'customBool'
-	// If no namespace is provided, 'default' will be used
+	// If no namespace is provided, the provider namespace will be used
 
 	var namespace, attributeName string
 
 	idTokens := strings.Split(req.ID, ":")
 	switch len(idTokens) {
 	case 1:
-		// One part: Attribute name with default namespace ('CustomBool')
-		namespace = "default"
+		// One part: Attribute name with the provider namespace ('CustomBool')
+		namespace = r.namespace
 		attributeName = idTokens[0]
 	case 2:
 		// Two parts: the first is namespace, second is attribute name ('default:CustomBool')
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"google.golang.org/grpc"
+)
+
+// listSearchAttributeTypes returns the indexed value type of every search attribute,
+// custom and system, registered in the namespace.
+func listSearchAttributeTypes(ctx context.Context, conn grpc.ClientConnInterface, namespace string) (map[string]enums.IndexedValueType, error) {
+	client := operatorservice.NewOperatorServiceClient(conn)
+	resp, err := client.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	saTypes := make(map[string]enums.IndexedValueType, len(resp.GetCustomAttributes())+len(resp.GetSystemAttributes()))
+	for name, t := range resp.GetSystemAttributes() {
+		saTypes[name] = t
+	}
+	for name, t := range resp.GetCustomAttributes() {
+		saTypes[name] = t
+	}
+
+	return saTypes, nil
+}
+
+// convertToSearchAttributes encodes Terraform search attribute values according to the
+// registered type of each attribute. Values are written in their string form, e.g. "42"
+// for Int, "true" for Bool, RFC3339 for Datetime and a JSON array for KeywordList.
+func convertToSearchAttributes(ctx context.Context, data types.Map, saTypes map[string]enums.IndexedValueType) (*commonv1.SearchAttributes, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make(map[string]string)
+	diags.Append(data.ElementsAs(ctx, &elements, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	searchAttributes := &commonv1.SearchAttributes{
+		IndexedFields: make(map[string]*commonv1.Payload, len(elements)),
+	}
+
+	names := make([]string, 0, len(elements))
+	for name := range elements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		saType, ok := saTypes[name]
+		if !ok {
+			diags.AddError(
+				"Unknown Search Attribute",
+				fmt.Sprintf("Search attribute %s is not registered in the namespace. Register it, e.g. with temporal_search_attribute, before using it.", name),
+			)
+			continue
+		}
+
+		payload, err := encodeSearchAttributeValue(elements[name], saType)
+		if err != nil {
+			diags.AddError(
+				"Invalid Search Attribute Value",
+				fmt.Sprintf("Unable to encode search attribute %s of type %s: %s", name, saType.String(), err),
+			)
+			continue
+		}
+		searchAttributes.IndexedFields[name] = payload
+	}
+
+	return searchAttributes, diags
+}
+
+// convertSearchAttributes decodes Temporal search attributes into their Terraform string form,
+// keeping the values of prior that encode the same value, e.g. "True" for true.
+// It returns a null map when there are no search attributes.
+func convertSearchAttributes(searchAttributes *commonv1.SearchAttributes, prior types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(searchAttributes.GetIndexedFields()) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	values := make(map[string]attr.Value, len(searchAttributes.GetIndexedFields()))
+	for name, payload := range searchAttributes.GetIndexedFields() {
+		if priorValue, ok := prior.Elements()[name].(types.String); ok && searchAttributeValueEqual(priorValue.ValueString(), payload) {
+			values[name] = priorValue
+			continue
+		}
+		value, err := decodeSearchAttributeValue(payload)
+		if err != nil {
+			diags.AddError(
+				"Invalid Search Attribute Value",
+				fmt.Sprintf("Unable to decode search attribute %s: %s", name, err),
+			)
+			continue
+		}
+		values[name] = types.StringValue(value)
+	}
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	return types.MapValueMust(types.StringType, values), diags
+}
+
+// scheduleSystemSearchAttributes are set by the Temporal server on every schedule.
+var scheduleSystemSearchAttributes = []string{"TemporalSchedulePaused", "TemporalNamespaceDivision"}
+
+// scheduleSearchAttributes drops the search attributes the server maintains on a schedule
+// unless they are managed in Terraform, so they do not show up as drift.
+func scheduleSearchAttributes(searchAttributes *commonv1.SearchAttributes, managed types.Map) *commonv1.SearchAttributes {
+	fields := make(map[string]*commonv1.Payload, len(searchAttributes.GetIndexedFields()))
+	for name, payload := range searchAttributes.GetIndexedFields() {
+		if _, ok := managed.Elements()[name]; !ok && slices.Contains(scheduleSystemSearchAttributes, name) {
+			continue
+		}
+		fields[name] = payload
+	}
+
+	return &commonv1.SearchAttributes{IndexedFields: fields}
+}
+
+// encodeSearchAttributeValue builds a search attribute payload carrying the value type in its metadata.
+func encodeSearchAttributeValue(value string, saType enums.IndexedValueType) (*commonv1.Payload, error) {
+	var (
+		decoded interface{}
+		err     error
+	)
+
+	switch saType {
+	case enums.INDEXED_VALUE_TYPE_TEXT, enums.INDEXED_VALUE_TYPE_KEYWORD:
+		decoded = value
+	case enums.INDEXED_VALUE_TYPE_INT:
+		decoded, err = strconv.ParseInt(value, 10, 64)
+	case enums.INDEXED_VALUE_TYPE_DOUBLE:
+		decoded, err = strconv.ParseFloat(value, 64)
+	case enums.INDEXED_VALUE_TYPE_BOOL:
+		decoded, err = strconv.ParseBool(value)
+	case enums.INDEXED_VALUE_TYPE_DATETIME:
+		_, err = time.Parse(time.RFC3339Nano, value)
+		decoded = value
+	case enums.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		var list []string
+		err = json.Unmarshal([]byte(value), &list)
+		if err != nil {
+			err = fmt.Errorf("expected a JSON array of strings, e.g. jsonencode([\"a\", \"b\"]): %w", err)
+		}
+		decoded = list
+	default:
+		return nil, fmt.Errorf("unsupported search attribute type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commonv1.Payload{
+		Metadata: map[string][]byte{
+			"encoding": []byte("json/plain"),
+			"type":     []byte(saType.String()),
+		},
+		Data: data,
+	}, nil
+}
+
+// decodeSearchAttributeValue renders a search attribute payload in the string form
+// accepted by encodeSearchAttributeValue.
+func decodeSearchAttributeValue(payload *commonv1.Payload) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload.GetData()))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return "", err
+	}
+
+	switch value := decoded.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
+
+// searchAttributeValueEqual reports whether value, in the string form accepted by
+// encodeSearchAttributeValue, encodes the same value as payload.
+func searchAttributeValueEqual(value string, payload *commonv1.Payload) bool {
+	current, err := decodeSearchAttributeValue(payload)
+	if err != nil {
+		return false
+	}
+	if value == current {
+		return true
+	}
+
+	saType, err := enums.IndexedValueTypeFromString(string(payload.GetMetadata()["type"]))
+	if err != nil {
+		return false
+	}
+	if saType == enums.INDEXED_VALUE_TYPE_DATETIME {
+		// Datetimes may be returned in another time zone or precision.
+		want, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return false
+		}
+		got, err := time.Parse(time.RFC3339Nano, current)
+		return err == nil && got.Equal(want)
+	}
+
+	encoded, err := encodeSearchAttributeValue(value, saType)
+	if err != nil {
+		return false
+	}
+	var want, got interface{}
+	if json.Unmarshal(encoded.GetData(), &want) != nil || json.Unmarshal(payload.GetData(), &got) != nil {
+		return false
+	}
+	return reflect.DeepEqual(got, want)
+}
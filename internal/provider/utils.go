@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	commonv1 "go.temporal.io/api/common/v1"
 	schedulev1 "go.temporal.io/api/schedule/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -16,6 +18,11 @@ func formatDurationCanonical(d *durationpb.Duration) string {
 		return ""
 	}
 
+	// Sub-second durations keep their precision, e.g. "500ms" or "1.5s".
+	if d.GetNanos() != 0 {
+		return d.AsDuration().String()
+	}
+
 	totalSeconds := d.GetSeconds()
 
 	// For common intervals, use human-readable format
@@ -28,12 +35,12 @@ func formatDurationCanonical(d *durationpb.Duration) string {
 		return "30m"
 	case 60: // 1 minute
 		return "1m"
+	case 0:
+		return "0s"
 	default:
-		// For other values, check if it's a clean division
-		if totalSeconds%(24*3600) == 0 {
-			// Clean days
-			return fmt.Sprintf("%dd", totalSeconds/(24*3600))
-		} else if totalSeconds%3600 == 0 {
+		// For other values, check if it's a clean division. Days are written as hours,
+		// since durations in configuration have no day unit.
+		if totalSeconds%3600 == 0 {
 			// Clean hours
 			return fmt.Sprintf("%dh", totalSeconds/3600)
 		} else if totalSeconds%60 == 0 {
@@ -46,16 +53,33 @@ func formatDurationCanonical(d *durationpb.Duration) string {
 	}
 }
 
-// createPayload converts any value to a Temporal Payload.
+// durationValue returns d as a duration string. It keeps prior when prior is the same
+// duration written differently, e.g. "1m30s" for a duration Temporal returns as 90s.
+func durationValue(prior types.String, d *durationpb.Duration) types.String {
+	if parsed, err := time.ParseDuration(prior.ValueString()); err == nil && parsed == d.AsDuration() {
+		return prior
+	}
+	return types.StringValue(formatDurationCanonical(d))
+}
+
+// createPayload converts a string to a json/plain Temporal Payload.
 func createPayload(value string) (*commonv1.Payload, error) {
 	data, err := json.Marshal(value)
 	if err != nil {
 		return nil, err
 	}
 
+	return createJSONPayload(data), nil
+}
+
+// createJSONPayload wraps an encoded JSON document into a json/plain Temporal Payload.
+func createJSONPayload(data []byte) *commonv1.Payload {
 	return &commonv1.Payload{
+		Metadata: map[string][]byte{
+			"encoding": []byte("json/plain"),
+		},
 		Data: data,
-	}, nil
+	}
 }
 
 // formatRanges converts a slice of Range objects into a comma-separated string representation.